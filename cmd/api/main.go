@@ -13,6 +13,7 @@ import (
 	"pr_reviewer_assignment_service/internal/repository/postgres"
 	"pr_reviewer_assignment_service/internal/server"
 	usecasePr "pr_reviewer_assignment_service/internal/usecase/pr"
+	usecaseStats "pr_reviewer_assignment_service/internal/usecase/stats"
 	usecaseTeam "pr_reviewer_assignment_service/internal/usecase/team"
 	usecaseUser "pr_reviewer_assignment_service/internal/usecase/user"
 	"pr_reviewer_assignment_service/pkg/logger"
@@ -46,12 +47,33 @@ func main() {
 	userRepo := postgres.NewUserRepository(db, log)
 	teamRepo := postgres.NewTeamRepository(db, log)
 	prRepo := postgres.NewPRRepository(db, log)
+	statsRepo := postgres.NewStatsRepository(db, log)
 
-	userSvc := usecaseUser.NewUserService(userRepo, prRepo, log)
-	teamSvc := usecaseTeam.NewTeamService(teamRepo, log)
-	prSvc := usecasePr.NewPRService(prRepo, teamRepo, userRepo, log)
+	selector, err := usecasePr.NewReviewerSelector(cfg.PRService.ReviewerStrategy, prRepo)
+	if err != nil {
+		log.Error(context.Background(), "failed to create reviewer selector", zap.Error(err))
+		os.Exit(1)
+	}
+	prSvc := usecasePr.NewPRService(prRepo, teamRepo, userRepo, selector, usecasePr.Config{
+		MaxReviewers:      cfg.PRService.MaxReviewers,
+		RequiredApprovals: cfg.PRService.RequiredApprovals,
+	}, log)
+	teamSvc := usecaseTeam.NewTeamService(teamRepo, prSvc, log)
+	userSvc := usecaseUser.NewUserService(userRepo, prRepo, prSvc, log)
+	statsSvc := usecaseStats.NewStatsService(statsRepo, usecaseStats.Config{
+		ImbalanceThreshold: cfg.Fairness.GiniThreshold,
+	}, log)
 
-	srv := server.NewServer(cfg, log, userSvc, prSvc, teamSvc)
+	srv := server.NewServer(cfg, log, userSvc, prSvc, teamSvc, statsSvc)
+
+	jobCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+	if cfg.Absence.ReassignInterval > 0 {
+		go userSvc.RunAbsenceJob(jobCtx, cfg.Absence.ReassignInterval)
+	}
+	if cfg.Fairness.CheckInterval > 0 && cfg.Fairness.GiniThreshold > 0 {
+		go statsSvc.RunFairnessJob(jobCtx, cfg.Fairness.CheckInterval)
+	}
 
 	go func() {
 		if err := srv.Start(); err != nil && err != http.ErrServerClosed {
@@ -65,6 +87,7 @@ func main() {
 	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
 	<-quit
 	log.Info(context.Background(), "shutting down server...")
+	stopJobs()
 
 	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
 	defer cancel()
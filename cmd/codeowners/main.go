@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"pr_reviewer_assignment_service/internal/config"
+	"pr_reviewer_assignment_service/internal/dto/user"
+	"pr_reviewer_assignment_service/internal/repository/postgres"
+	usecaseUser "pr_reviewer_assignment_service/internal/usecase/user"
+	"pr_reviewer_assignment_service/pkg/logger"
+
+	"github.com/jmoiron/sqlx"
+	_ "github.com/lib/pq"
+)
+
+// Импортирует CODEOWNERS-файл репозитория -repository напрямую в базу
+// сервиса и печатает отчёт в том же виде, что и /codeowners/import. Правила
+// других репозиториев не затрагиваются.
+func main() {
+	var path, repository string
+	var dryRun bool
+
+	flag.StringVar(&path, "file", ".github/CODEOWNERS", "path to CODEOWNERS file")
+	flag.StringVar(&repository, "repository", "", "repository the CODEOWNERS file belongs to; empty for PRs without a repository")
+	flag.BoolVar(&dryRun, "dry-run", false, "parse and resolve owners without storing rules")
+	flag.Parse()
+
+	envPath := os.Getenv("ENV_PATH")
+	if envPath == "" {
+		envPath = "configs/.env"
+	}
+
+	cfg, err := config.ParseConfig(envPath)
+	if err != nil {
+		fmt.Printf("error parsing config: %v\n", err)
+		os.Exit(1)
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		fmt.Printf("failed to read %s: %v\n", path, err)
+		os.Exit(1)
+	}
+
+	log := logger.NewLogger(cfg.Environment)
+	defer log.Sync()
+
+	dsn := fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
+		cfg.Postgres.Host, cfg.Postgres.Port, cfg.Postgres.User,
+		cfg.Postgres.Password, cfg.Postgres.DBName, cfg.Postgres.SSLMode,
+	)
+	db, err := sqlx.Connect("postgres", dsn)
+	if err != nil {
+		fmt.Printf("failed to connect to postgres: %v\n", err)
+		os.Exit(1)
+	}
+	defer db.Close()
+
+	userSvc := usecaseUser.NewUserService(postgres.NewUserRepository(db, log), nil, nil, log)
+
+	resp, err := userSvc.ImportCodeowners(context.Background(), &user.ImportCodeownersRequest{
+		Repository: repository,
+		Content:    string(content),
+		DryRun:     dryRun,
+	})
+	if err != nil {
+		fmt.Printf("import failed: %v\n", err)
+		os.Exit(1)
+	}
+
+	report, _ := json.MarshalIndent(resp, "", "  ")
+	fmt.Println(string(report))
+}
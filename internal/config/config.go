@@ -33,7 +33,18 @@ type Config struct {
 	}
 
 	PRService struct {
-		MaxReviewers int `env:"MAX_REVIEWERS" env-default:"2"`
+		MaxReviewers      int    `env:"MAX_REVIEWERS" env-default:"2"`
+		ReviewerStrategy  string `env:"REVIEWER_STRATEGY" env-default:"round_robin"` // round_robin, random, least_loaded
+		RequiredApprovals int    `env:"REQUIRED_APPROVALS" env-default:"0"`          // 0 — merge без обязательных approve
+	}
+
+	Absence struct {
+		ReassignInterval time.Duration `env:"ABSENCE_REASSIGN_INTERVAL" env-default:"1m"` // 0 — фоновое переназначение выключено
+	}
+
+	Fairness struct {
+		GiniThreshold float64       `env:"FAIRNESS_GINI_THRESHOLD" env-default:"0.4"` // 0 — предупреждения о дисбалансе выключены
+		CheckInterval time.Duration `env:"FAIRNESS_CHECK_INTERVAL" env-default:"5m"`  // 0 — фоновая проверка выключена
 	}
 }
 
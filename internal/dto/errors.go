@@ -8,8 +8,47 @@ var (
 	ErrUserExists  = errors.New("user already exists")
 	ErrPRExists    = errors.New("pull request already exists")
 	ErrPRMerged    = errors.New("pull request already merged")
+	ErrPRNotOpen   = errors.New("pull request is not open")
 	ErrNotAssigned = errors.New("reviewer not assigned")
 	ErrNoCandidate = errors.New("no candidate available")
+
+	ErrInvalidReviewersCount = errors.New("invalid reviewers count")
+	ErrNotEnoughReviewers    = errors.New("not enough reviewers available")
+
+	ErrReviewerInactive = errors.New("reviewer is not active")
+	ErrReviewerAbsent   = errors.New("reviewer is absent")
+	ErrReviewerIsAuthor = errors.New("author cannot review own pull request")
+	ErrAlreadyAssigned  = errors.New("reviewer already assigned")
+	ErrTeamNotAllowed   = errors.New("reviewer is not in an allowed team")
+	ErrReviewerAtLimit  = errors.New("reviewer reached open reviews limit")
+	ErrInvalidCapacity  = errors.New("invalid max open reviews")
+
+	ErrInvalidTransition = errors.New("invalid pull request status transition")
+
+	ErrInvalidVerdict = errors.New("invalid review verdict")
+	ErrInvalidPolicy  = errors.New("invalid merge policy")
+
+	ErrInvalidTeamUpdate = errors.New("conflicting team update")
+	ErrNotTeamMember     = errors.New("user is not a member of the team")
+	ErrInvalidMembership = errors.New("invalid team membership")
+	ErrInvalidDeactivate = errors.New("invalid deactivation request")
+	ErrInvalidFallback   = errors.New("invalid fallback teams")
+
+	ErrInvalidDeleteMode = errors.New("invalid team delete mode")
+	ErrTeamHasHistory    = errors.New("team has pull request history")
+	ErrTeamArchived      = errors.New("team is archived")
+
+	ErrSameTeam        = errors.New("user already belongs to the team")
+	ErrInvalidMoveMode = errors.New("invalid open reviews mode")
+	ErrInvalidAbsence  = errors.New("invalid absence period")
+
+	ErrInvalidOwnership  = errors.New("invalid ownership pattern")
+	ErrInvalidCodeowners = errors.New("invalid CODEOWNERS file")
+
+	ErrInvalidTimeRange = errors.New("invalid time range")
+	ErrInvalidListQuery = errors.New("invalid pull request list query")
+
+	ErrReviewersChanged = errors.New("pull request reviewers changed concurrently, retry the request")
 )
 
 type ErrorResponse struct {
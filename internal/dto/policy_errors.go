@@ -0,0 +1,24 @@
+package dto
+
+import "errors"
+
+var ErrMergeBlocked = errors.New("merge blocked by policy")
+
+type PolicyViolation struct {
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+// MergeBlockedError возвращается, когда PR не проходит политику merge команды.
+// errors.Is(err, ErrMergeBlocked) для неё истинно.
+type MergeBlockedError struct {
+	Violations []PolicyViolation
+}
+
+func (e *MergeBlockedError) Error() string {
+	return ErrMergeBlocked.Error()
+}
+
+func (e *MergeBlockedError) Is(target error) bool {
+	return target == ErrMergeBlocked
+}
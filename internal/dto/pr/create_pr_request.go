@@ -4,4 +4,13 @@ type CreatePRRequest struct {
 	PullRequestID   string `json:"pull_request_id"`
 	PullRequestName string `json:"pull_request_name"`
 	AuthorID        string `json:"author_id"`
+	TeamName        string `json:"team_name,omitempty"`
+	ReviewersCount  *int   `json:"reviewers_count,omitempty"`
+	Draft           bool   `json:"draft,omitempty"`
+	// Repository — репозиторий PR; владельцы ChangedFiles определяются по
+	// импортированному CODEOWNERS этого репозитория.
+	Repository string `json:"repository,omitempty"`
+	// ChangedFiles — пути изменённых файлов; по ним подбираются ревьюверы,
+	// владеющие этими файлами.
+	ChangedFiles []string `json:"changed_files,omitempty"`
 }
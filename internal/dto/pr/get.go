@@ -0,0 +1,34 @@
+package pr
+
+type AuthorDTO struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+	TeamName string `json:"team_name"`
+	IsActive bool   `json:"is_active"`
+}
+
+type ReviewerDTO struct {
+	UserID     string  `json:"user_id"`
+	Username   string  `json:"username"`
+	AssignedAt *string `json:"assigned_at"`
+	Verdict    string  `json:"verdict,omitempty"`
+	VerdictAt  *string `json:"verdict_at,omitempty"`
+	Fallback   bool    `json:"fallback,omitempty"`
+}
+
+// PRDetailResponse — PR со сведениями об авторе и ревьюверах. Author
+// отсутствует, если автор удалён.
+type PRDetailResponse struct {
+	PullRequestID   string        `json:"pull_request_id"`
+	PullRequestName string        `json:"pull_request_name"`
+	AuthorID        string        `json:"author_id,omitempty"`
+	Author          *AuthorDTO    `json:"author,omitempty"`
+	TeamName        string        `json:"team_name,omitempty"`
+	Status          string        `json:"status"`
+	Reviewers       []ReviewerDTO `json:"reviewers"`
+	UnderStaffed    bool          `json:"under_staffed,omitempty"`
+	Repository      string        `json:"repository,omitempty"`
+	ChangedFiles    []string      `json:"changed_files,omitempty"`
+	CreatedAt       *string       `json:"created_at"`
+	MergedAt        *string       `json:"merged_at"`
+}
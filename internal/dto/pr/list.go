@@ -0,0 +1,24 @@
+package pr
+
+// ListPRsRequest — параметры /pull-request/list в том виде, в каком они
+// пришли в query string. Даты — RFC3339, Cursor — next_cursor предыдущей
+// страницы.
+type ListPRsRequest struct {
+	Statuses    []string
+	AuthorID    string
+	ReviewerID  string
+	TeamName    string
+	CreatedFrom string
+	CreatedTo   string
+	MergedFrom  string
+	MergedTo    string
+	SortBy      string
+	Order       string
+	Limit       string
+	Cursor      string
+}
+
+type ListPRsResponse struct {
+	PullRequests []PRResponse `json:"pull_requests"`
+	NextCursor   *string      `json:"next_cursor,omitempty"`
+}
@@ -1,11 +1,17 @@
 package pr
 
 type PRResponse struct {
-	PullRequestID     string   `json:"pull_request_id"`
-	PullRequestName   string   `json:"pull_request_name"`
-	AuthorID          string   `json:"author_id"`
-	Status            string   `json:"status"`
-	AssignedReviewers []string `json:"assigned_reviewers"`
-	CreatedAt         *string  `json:"createdAt,omitempty"`
-	MergedAt          *string  `json:"mergedAt,omitempty"`
+	PullRequestID     string      `json:"pull_request_id"`
+	PullRequestName   string      `json:"pull_request_name"`
+	AuthorID          string      `json:"author_id"`
+	TeamName          string      `json:"team_name,omitempty"`
+	Status            string      `json:"status"`
+	AssignedReviewers []string    `json:"assigned_reviewers"`
+	FallbackReviewers []string    `json:"fallback_reviewers,omitempty"`
+	Reviews           []ReviewDTO `json:"reviews,omitempty"`
+	UnderStaffed      bool        `json:"under_staffed,omitempty"`
+	Repository        string      `json:"repository,omitempty"`
+	ChangedFiles      []string    `json:"changed_files,omitempty"`
+	CreatedAt         *string     `json:"created_at,omitempty"`
+	MergedAt          *string     `json:"merged_at,omitempty"`
 }
@@ -0,0 +1,8 @@
+package pr
+
+type ReassignmentDTO struct {
+	PullRequestID string `json:"pull_request_id"`
+	OldUserID     string `json:"old_user_id"`
+	NewUserID     string `json:"new_user_id,omitempty"`
+	Released      bool   `json:"released,omitempty"`
+}
@@ -0,0 +1,9 @@
+package pr
+
+type ReviewDTO struct {
+	UserID     string  `json:"user_id"`
+	Verdict    string  `json:"verdict,omitempty"`
+	AssignedAt *string `json:"assigned_at,omitempty"`
+	VerdictAt  *string `json:"verdict_at,omitempty"`
+	Fallback   bool    `json:"fallback,omitempty"`
+}
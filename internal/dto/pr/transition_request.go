@@ -0,0 +1,5 @@
+package pr
+
+type TransitionRequest struct {
+	PullRequestID string `json:"pull_request_id"`
+}
@@ -0,0 +1,16 @@
+package stats
+
+type TeamFairnessDTO struct {
+	TeamName    string   `json:"team_name"`
+	Members     int      `json:"members"`
+	OpenReviews int      `json:"open_reviews"`
+	Gini        float64  `json:"gini"`
+	MaxMinRatio *float64 `json:"max_min_ratio"`
+	StdDev      float64  `json:"std_dev"`
+	Imbalanced  bool     `json:"imbalanced"`
+}
+
+type FairnessResponse struct {
+	Threshold float64           `json:"threshold"`
+	Teams     []TeamFairnessDTO `json:"teams"`
+}
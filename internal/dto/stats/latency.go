@@ -0,0 +1,31 @@
+package stats
+
+type PercentilesDTO struct {
+	Samples    int     `json:"samples"`
+	P50Seconds float64 `json:"p50_seconds"`
+	P90Seconds float64 `json:"p90_seconds"`
+	P99Seconds float64 `json:"p99_seconds"`
+}
+
+type LatencyDTO struct {
+	TimeToFirstReview *PercentilesDTO `json:"time_to_first_review,omitempty"`
+	TimeToMerge       *PercentilesDTO `json:"time_to_merge,omitempty"`
+}
+
+type TeamLatencyDTO struct {
+	TeamName string `json:"team_name"`
+	LatencyDTO
+}
+
+type ReviewerLatencyDTO struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+	LatencyDTO
+}
+
+type LatencyResponse struct {
+	From      *string              `json:"from,omitempty"`
+	To        *string              `json:"to,omitempty"`
+	Teams     []TeamLatencyDTO     `json:"teams"`
+	Reviewers []ReviewerLatencyDTO `json:"reviewers"`
+}
@@ -0,0 +1,36 @@
+package stats
+
+type ReviewCountersDTO struct {
+	Assignments     int `json:"assignments"`
+	OpenAssignments int `json:"open_assignments"`
+	ReassignedAway  int `json:"reassigned_away"`
+	MergedReviewed  int `json:"merged_reviewed"`
+}
+
+type ReviewerStatsDTO struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+	TeamName string `json:"team_name"`
+	ReviewCountersDTO
+}
+
+type TeamReviewStatsDTO struct {
+	TeamName string `json:"team_name"`
+	Members  int    `json:"members"`
+	ReviewCountersDTO
+}
+
+// StatsRequest — фильтры статистики: команда и полуинтервал [From, To) в
+// RFC3339.
+type StatsRequest struct {
+	TeamName string
+	From     string
+	To       string
+}
+
+type ReviewerStatsResponse struct {
+	From  *string              `json:"from,omitempty"`
+	To    *string              `json:"to,omitempty"`
+	Users []ReviewerStatsDTO   `json:"users"`
+	Teams []TeamReviewStatsDTO `json:"teams"`
+}
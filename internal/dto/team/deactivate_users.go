@@ -0,0 +1,15 @@
+package team
+
+import pr "pr_reviewer_assignment_service/internal/dto/pr"
+
+type DeactivateUsersRequest struct {
+	TeamName string   `json:"team_name"`
+	UserIDs  []string `json:"user_ids,omitempty"`
+	All      bool     `json:"all,omitempty"`
+}
+
+type DeactivateUsersResponse struct {
+	TeamName      string               `json:"team_name"`
+	Deactivated   []string             `json:"deactivated"`
+	Reassignments []pr.ReassignmentDTO `json:"reassignments"`
+}
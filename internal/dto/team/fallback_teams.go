@@ -0,0 +1,6 @@
+package team
+
+type FallbackTeamsDTO struct {
+	TeamName      string   `json:"team_name"`
+	FallbackTeams []string `json:"fallback_teams"`
+}
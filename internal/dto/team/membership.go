@@ -0,0 +1,16 @@
+package team
+
+type SetMembershipRequest struct {
+	TeamName string  `json:"team_name"`
+	UserID   string  `json:"user_id"`
+	Role     *string `json:"role,omitempty"`
+	IsActive *bool   `json:"is_active,omitempty"`
+}
+
+type MembershipResponse struct {
+	TeamName string `json:"team_name"`
+	UserID   string `json:"user_id"`
+	Role     string `json:"role"`
+	IsActive bool   `json:"is_active"`
+	JoinedAt string `json:"joined_at"`
+}
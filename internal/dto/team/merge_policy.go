@@ -0,0 +1,9 @@
+package team
+
+type MergePolicyDTO struct {
+	TeamName                 string  `json:"team_name"`
+	MinApprovals             int     `json:"min_approvals"`
+	BlockOnChangesRequested  bool    `json:"block_on_changes_requested"`
+	ForbidAuthorOnlyApproval bool    `json:"forbid_author_only_approval"`
+	RequiredReviewerTeam     *string `json:"required_reviewer_team,omitempty"`
+}
@@ -0,0 +1,6 @@
+package team
+
+type SetMaxOpenReviewsRequest struct {
+	TeamName       string `json:"team_name"`
+	MaxOpenReviews *int   `json:"max_open_reviews"`
+}
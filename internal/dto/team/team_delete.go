@@ -0,0 +1,14 @@
+package team
+
+import pr "pr_reviewer_assignment_service/internal/dto/pr"
+
+type DeleteTeamRequest struct {
+	TeamName string `json:"team_name"`
+	Mode     string `json:"mode"`
+}
+
+type DeleteTeamResponse struct {
+	TeamName      string               `json:"team_name"`
+	Mode          string               `json:"mode"`
+	Reassignments []pr.ReassignmentDTO `json:"reassignments"`
+}
@@ -1,7 +1,10 @@
 package team
 
 type TeamMember struct {
-	UserID   string `json:"user_id" db:"user_id"`
-	Username string `json:"username" db:"username"`
-	IsActive bool   `json:"is_active" db:"is_active"`
+	UserID         string `json:"user_id" db:"user_id"`
+	Username       string `json:"username" db:"username"`
+	IsActive       bool   `json:"is_active" db:"is_active"`
+	Role           string `json:"role,omitempty" db:"role"`
+	MaxOpenReviews *int   `json:"max_open_reviews,omitempty" db:"max_open_reviews"`
+	Absent         bool   `json:"absent,omitempty" db:"absent"`
 }
@@ -1,6 +1,8 @@
 package team
 
 type TeamRequest struct {
-	TeamName string       `json:"team_name"`
-	Members  []TeamMember `json:"members"`
+	TeamName       string       `json:"team_name"`
+	MaxReviewers   *int         `json:"max_reviewers,omitempty"`
+	MaxOpenReviews *int         `json:"max_open_reviews,omitempty"`
+	Members        []TeamMember `json:"members"`
 }
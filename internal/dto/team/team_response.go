@@ -1,6 +1,9 @@
 package team
 
 type TeamResponse struct {
-	TeamName string       `json:"team_name"`
-	Members  []TeamMember `json:"members"`
+	TeamName       string       `json:"team_name"`
+	MaxReviewers   *int         `json:"max_reviewers,omitempty"`
+	MaxOpenReviews *int         `json:"max_open_reviews,omitempty"`
+	ArchivedAt     *string      `json:"archived_at,omitempty"`
+	Members        []TeamMember `json:"members"`
 }
@@ -0,0 +1,20 @@
+package team
+
+import pr "pr_reviewer_assignment_service/internal/dto/pr"
+
+type RenameMember struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+}
+
+type UpdateTeamRequest struct {
+	TeamName      string         `json:"team_name"`
+	AddMembers    []TeamMember   `json:"add_members,omitempty"`
+	RemoveMembers []string       `json:"remove_members,omitempty"`
+	RenameMembers []RenameMember `json:"rename_members,omitempty"`
+}
+
+type UpdateTeamResponse struct {
+	Team          TeamResponse         `json:"team"`
+	Reassignments []pr.ReassignmentDTO `json:"reassignments"`
+}
@@ -0,0 +1,30 @@
+package user
+
+import "time"
+
+type AddAbsenceRequest struct {
+	UserID              string    `json:"user_id"`
+	StartsAt            time.Time `json:"starts_at"`
+	EndsAt              time.Time `json:"ends_at"`
+	Reason              string    `json:"reason,omitempty"`
+	ReassignOpenReviews bool      `json:"reassign_open_reviews,omitempty"`
+}
+
+type DeleteAbsenceRequest struct {
+	AbsenceID int64 `json:"absence_id"`
+}
+
+type AbsenceResponse struct {
+	AbsenceID           int64   `json:"absence_id"`
+	UserID              string  `json:"user_id"`
+	StartsAt            string  `json:"starts_at"`
+	EndsAt              string  `json:"ends_at"`
+	Reason              string  `json:"reason,omitempty"`
+	ReassignOpenReviews bool    `json:"reassign_open_reviews"`
+	ReassignedAt        *string `json:"reassigned_at,omitempty"`
+}
+
+type GetAbsencesResponse struct {
+	UserID   string            `json:"user_id"`
+	Absences []AbsenceResponse `json:"absences"`
+}
@@ -0,0 +1,25 @@
+package user
+
+type ImportCodeownersRequest struct {
+	// Repository — репозиторий, чей CODEOWNERS импортируется; правила
+	// других репозиториев сохраняются. Пустое значение — набор правил по
+	// умолчанию для PR без репозитория.
+	Repository string `json:"repository,omitempty"`
+	Content    string `json:"content"`
+	DryRun     bool   `json:"dry_run,omitempty"`
+}
+
+type ImportCodeownersResponse struct {
+	Repository    string `json:"repository,omitempty"`
+	RulesImported int    `json:"rules_imported"`
+	DryRun        bool   `json:"dry_run,omitempty"`
+	// InvalidLines — номера строк с некорректным шаблоном; такие строки
+	// пропущены.
+	InvalidLines []int `json:"invalid_lines"`
+	// UnknownHandles — владельцы, не сопоставленные ни с пользователем, ни
+	// с командой сервиса.
+	UnknownHandles []string `json:"unknown_handles"`
+	// AmbiguousHandles — @user, которому соответствует несколько
+	// пользователей с одинаковым именем.
+	AmbiguousHandles []string `json:"ambiguous_handles"`
+}
@@ -0,0 +1,16 @@
+package user
+
+import pr "pr_reviewer_assignment_service/internal/dto/pr"
+
+type MoveUserRequest struct {
+	UserID      string `json:"user_id"`
+	TeamName    string `json:"team_name"`
+	OpenReviews string `json:"open_reviews,omitempty"`
+}
+
+type MoveUserResponse struct {
+	User          UserResponse         `json:"user"`
+	FromTeam      string               `json:"from_team"`
+	MovedAt       string               `json:"moved_at"`
+	Reassignments []pr.ReassignmentDTO `json:"reassignments"`
+}
@@ -0,0 +1,8 @@
+package user
+
+// OwnershipPatternsDTO — шаблоны путей в стиле CODEOWNERS, которыми владеет
+// пользователь.
+type OwnershipPatternsDTO struct {
+	UserID   string   `json:"user_id"`
+	Patterns []string `json:"patterns"`
+}
@@ -1,6 +1,16 @@
 package user
 
+import pr "pr_reviewer_assignment_service/internal/dto/pr"
+
 type SetIsActiveRequest struct {
 	UserID   string `json:"user_id" db:"user_id"`
 	IsActive bool   `json:"is_active" db:"is_active"`
+	// ReassignOpenReviews при выключении переназначает открытые ревью
+	// пользователя в той же транзакции.
+	ReassignOpenReviews bool `json:"reassign_open_reviews,omitempty"`
+}
+
+type DeactivateResponse struct {
+	User          UserResponse         `json:"user"`
+	Reassignments []pr.ReassignmentDTO `json:"reassignments"`
 }
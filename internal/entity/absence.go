@@ -0,0 +1,16 @@
+package entity
+
+import "time"
+
+// Absence — период отсутствия пользователя [StartsAt, EndsAt). Пока он
+// длится, пользователь не назначается ревьювером.
+type Absence struct {
+	AbsenceID           int64      `db:"absence_id"`
+	UserID              string     `db:"user_id"`
+	StartsAt            time.Time  `db:"starts_at"`
+	EndsAt              time.Time  `db:"ends_at"`
+	Reason              string     `db:"reason"`
+	ReassignOpenReviews bool       `db:"reassign_open_reviews"`
+	ReassignedAt        *time.Time `db:"reassigned_at"`
+	CreatedAt           time.Time  `db:"created_at"`
+}
@@ -0,0 +1,12 @@
+package entity
+
+// CodeownersRule — правило импортированного CODEOWNERS-файла. Правила
+// применяются в порядке Position, для файла действует последнее подходящее.
+// При чтении UserIDs включает и участников команд из Teams.
+type CodeownersRule struct {
+	Position int    `db:"position"`
+	Line     int    `db:"line"`
+	Pattern  string `db:"pattern"`
+	UserIDs  []string
+	Teams    []string
+}
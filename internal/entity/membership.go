@@ -0,0 +1,18 @@
+package entity
+
+import "time"
+
+const (
+	RoleMember = "member"
+	RoleLead   = "lead"
+)
+
+// Membership — участие пользователя в команде. Основная команда
+// пользователя хранится в User.TeamName, остальные — только здесь.
+type Membership struct {
+	TeamName string    `db:"team_name"`
+	UserID   string    `db:"user_id"`
+	Role     string    `db:"role"`
+	IsActive bool      `db:"is_active"`
+	JoinedAt time.Time `db:"joined_at"`
+}
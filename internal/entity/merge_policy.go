@@ -0,0 +1,11 @@
+package entity
+
+// MergePolicy — правила merge для PR команды. ForbidAuthorOnlyApproval
+// требует хотя бы одного одобрения не от автора, даже если MinApprovals = 0.
+type MergePolicy struct {
+	TeamName                 string  `db:"team_name"`
+	MinApprovals             int     `db:"min_approvals"`
+	BlockOnChangesRequested  bool    `db:"block_on_changes_requested"`
+	ForbidAuthorOnlyApproval bool    `db:"forbid_author_only_approval"`
+	RequiredReviewerTeam     *string `db:"required_reviewer_team"`
+}
@@ -5,16 +5,81 @@ import "time"
 type PRStatus string
 
 const (
+	StatusDraft  PRStatus = "DRAFT"
 	StatusOpen   PRStatus = "OPEN"
 	StatusMerged PRStatus = "MERGED"
+	StatusClosed PRStatus = "CLOSED"
+)
+
+type ReviewVerdict string
+
+const (
+	VerdictApproved         ReviewVerdict = "APPROVED"
+	VerdictChangesRequested ReviewVerdict = "CHANGES_REQUESTED"
+	VerdictCommented        ReviewVerdict = "COMMENTED"
 )
 
 type PullRequest struct {
 	PullRequestID     string   `db:"pull_request_id"`
 	Name              string   `db:"pull_request_name"`
 	AuthorID          string   `db:"author_id"`
+	TeamName          string   `db:"team_name"`
 	Status            PRStatus `db:"status"`
 	AssignedReviewers []string
-	CreatedAt         *time.Time `db:"created_at"`
-	MergedAt          *time.Time `db:"merged_at"`
+	// FallbackReviewers — подмножество AssignedReviewers, взятое из
+	// резервных команд.
+	FallbackReviewers []string
+	Reviews           []Review
+	// Repository — репозиторий PR; по нему выбираются правила CODEOWNERS.
+	Repository string `db:"repository"`
+	// ChangedFiles — пути изменённых файлов относительно корня репозитория.
+	ChangedFiles []string
+	// UnderStaffed — ревьюверов назначено меньше нужного, потому что
+	// остальные кандидаты упёрлись в лимит открытых ревью.
+	UnderStaffed bool       `db:"under_staffed"`
+	CreatedAt    *time.Time `db:"created_at"`
+	MergedAt     *time.Time `db:"merged_at"`
+}
+
+type Review struct {
+	UserID string `db:"user_id"`
+	// Username заполняется только при чтении PR с ревьюверами.
+	Username   string        `db:"username"`
+	Verdict    ReviewVerdict `db:"verdict"`
+	AssignedAt *time.Time    `db:"assigned_at"`
+	VerdictAt  *time.Time    `db:"verdict_at"`
+	Fallback   bool          `db:"is_fallback"`
+}
+
+type PRSortField string
+
+const (
+	SortByCreatedAt PRSortField = "created_at"
+	SortByMergedAt  PRSortField = "merged_at"
+)
+
+// PRListFilter — фильтры, сортировка и страница списка PR. Интервалы дат —
+// полуинтервалы [From, To). Сортировка по merged_at оставляет только
+// влитые PR.
+type PRListFilter struct {
+	Statuses    []PRStatus
+	AuthorID    string
+	ReviewerID  string
+	TeamName    string
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	MergedFrom  *time.Time
+	MergedTo    *time.Time
+	SortBy      PRSortField
+	Desc        bool
+	// After — ключ последнего PR предыдущей страницы.
+	After *PRListKey
+	Limit int
+}
+
+// PRListKey — позиция PR в списке: значение поля сортировки и id для
+// однозначного порядка при совпадающих датах.
+type PRListKey struct {
+	At            time.Time
+	PullRequestID string
 }
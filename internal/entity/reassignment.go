@@ -0,0 +1,11 @@
+package entity
+
+// Reassignment — результат переназначения ревью. Пустой NewUserID означает,
+// что подходящего кандидата не нашлось: ревьювер либо остался прежним, либо
+// снят с PR (Released).
+type Reassignment struct {
+	PullRequestID string
+	OldUserID     string
+	NewUserID     string
+	Released      bool
+}
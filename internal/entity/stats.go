@@ -0,0 +1,83 @@
+package entity
+
+import "time"
+
+// StatsFilter ограничивает статистику командой и полуинтервалом [From, To).
+// Пустые поля не ограничивают выборку.
+type StatsFilter struct {
+	TeamName string
+	From     *time.Time
+	To       *time.Time
+}
+
+// ReviewCounters — счётчики ревью. OpenAssignments — текущее состояние и от
+// временного интервала не зависит.
+type ReviewCounters struct {
+	Assignments     int `db:"assignments"`
+	OpenAssignments int `db:"open_assignments"`
+	ReassignedAway  int `db:"reassigned_away"`
+	MergedReviewed  int `db:"merged_reviewed"`
+}
+
+type ReviewerStats struct {
+	UserID   string `db:"user_id"`
+	Username string `db:"username"`
+	TeamName string `db:"team_name"`
+	ReviewCounters
+}
+
+// TeamReviewStats — сумма счётчиков участников команды.
+type TeamReviewStats struct {
+	TeamName string `db:"team_name"`
+	Members  int    `db:"members"`
+	ReviewCounters
+}
+
+const (
+	LatencyFirstReview = "first_review"
+	LatencyMerge       = "merge"
+)
+
+// LatencyStats — перцентили длительности одной метрики (Metric) в секундах
+// для команды TeamName или ревьювера UserID.
+type LatencyStats struct {
+	TeamName string  `db:"team_name"`
+	UserID   string  `db:"user_id"`
+	Username string  `db:"username"`
+	Metric   string  `db:"metric"`
+	Samples  int     `db:"samples"`
+	P50      float64 `db:"p50"`
+	P90      float64 `db:"p90"`
+	P99      float64 `db:"p99"`
+}
+
+// MemberLoad — число открытых ревью активного участника команды.
+type MemberLoad struct {
+	TeamName    string `db:"team_name"`
+	UserID      string `db:"user_id"`
+	OpenReviews int    `db:"open_reviews"`
+}
+
+// TeamFairness — равномерность распределения открытых ревью между активными
+// участниками команды. MaxMinRatio — nil, если у кого-то из участников нет
+// открытых ревью, а у кого-то есть.
+type TeamFairness struct {
+	TeamName    string
+	Members     int
+	OpenReviews int
+	Gini        float64
+	MaxMinRatio *float64
+	StdDev      float64
+	Imbalanced  bool
+}
+
+// ImbalanceEvent — переход команды в несбалансированное состояние
+// (Imbalanced) или возврат из него.
+type ImbalanceEvent struct {
+	EventID    int64     `db:"event_id"`
+	TeamName   string    `db:"team_name"`
+	Imbalanced bool      `db:"imbalanced"`
+	Gini       float64   `db:"gini"`
+	Threshold  float64   `db:"threshold"`
+	CreatedAt  time.Time `db:"created_at"`
+}
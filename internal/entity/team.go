@@ -1,6 +1,21 @@
 package entity
 
+import "time"
+
 type Team struct {
-	TeamName string `db:"team_name"`
-	Members  []User
+	TeamName     string `db:"team_name"`
+	MaxReviewers *int   `db:"max_reviewers"`
+	// MaxOpenReviews — лимит открытых ревью для участников без личного
+	// лимита.
+	MaxOpenReviews *int       `db:"max_open_reviews"`
+	ArchivedAt     *time.Time `db:"archived_at"`
+	Members        []User
+}
+
+// TeamUpdate описывает частичное изменение состава команды.
+type TeamUpdate struct {
+	TeamName string
+	Add      []User
+	Remove   []string
+	Rename   []User
 }
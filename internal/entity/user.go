@@ -1,8 +1,25 @@
 package entity
 
+import "time"
+
+// User — пользователь с основной командой TeamName. При загрузке состава
+// команды Role и IsActive относятся к участию именно в этой команде.
 type User struct {
 	UserID   string `db:"user_id"`
 	Username string `db:"username"`
 	TeamName string `db:"team_name"`
 	IsActive bool   `db:"is_active"`
+	Role     string `db:"role"`
+	// MaxOpenReviews — личный лимит открытых ревью; nil — лимит команды.
+	MaxOpenReviews *int `db:"max_open_reviews"`
+	// Absent — у пользователя сейчас идёт период отсутствия.
+	Absent bool `db:"absent"`
+}
+
+// UserMove — запись о переводе пользователя между командами.
+type UserMove struct {
+	UserID   string    `db:"user_id"`
+	FromTeam string    `db:"from_team"`
+	ToTeam   string    `db:"to_team"`
+	MovedAt  time.Time `db:"moved_at"`
 }
@@ -15,3 +15,15 @@ func writeError(w http.ResponseWriter, status int, code, msg string) {
 		},
 	})
 }
+
+func writeErrorWithDetails(w http.ResponseWriter, status int, code, msg string, details interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":    code,
+			"message": msg,
+			"details": details,
+		},
+	})
+}
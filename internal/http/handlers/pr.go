@@ -1,8 +1,11 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
+	"strings"
 
 	"pr_reviewer_assignment_service/internal/dto"
 	"pr_reviewer_assignment_service/internal/dto/pr"
@@ -39,6 +42,12 @@ func (h *PRHandler) CreatePR(w http.ResponseWriter, r *http.Request) {
 		switch err {
 		case dto.ErrPRExists:
 			writeError(w, http.StatusConflict, "PR_EXISTS", err.Error())
+		case dto.ErrInvalidReviewersCount:
+			writeError(w, http.StatusBadRequest, "INVALID_INPUT", err.Error())
+		case dto.ErrNotEnoughReviewers:
+			writeError(w, http.StatusConflict, "NOT_ENOUGH_REVIEWERS", err.Error())
+		case dto.ErrNotTeamMember:
+			writeError(w, http.StatusBadRequest, "NOT_TEAM_MEMBER", err.Error())
 		case dto.ErrNotFound:
 			writeError(w, http.StatusNotFound, "NOT_FOUND", err.Error())
 		default:
@@ -64,9 +73,16 @@ func (h *PRHandler) MergePR(w http.ResponseWriter, r *http.Request) {
 	resp, err := h.svc.MergePR(r.Context(), &req)
 	if err != nil {
 		h.svc.Logger().Error(r.Context(), "MergePR failed", zap.Error(err))
+		var blocked *dto.MergeBlockedError
+		if errors.As(err, &blocked) {
+			writeErrorWithDetails(w, http.StatusConflict, "MERGE_BLOCKED", err.Error(), blocked.Violations)
+			return
+		}
 		switch err {
 		case dto.ErrNotFound:
 			writeError(w, http.StatusNotFound, "NOT_FOUND", err.Error())
+		case dto.ErrInvalidTransition:
+			writeError(w, http.StatusConflict, "INVALID_TRANSITION", err.Error())
 		default:
 			writeError(w, http.StatusInternalServerError, "INTERNAL", err.Error())
 		}
@@ -88,6 +104,7 @@ func (h *PRHandler) ReassignPR(w http.ResponseWriter, r *http.Request) {
 	h.svc.Logger().Info(r.Context(), "ReassignPR request received",
 		zap.String("pull_request_id", req.PullRequestID),
 		zap.String("old_user_id", req.OldUserID),
+		zap.String("new_user_id", req.NewUserID),
 	)
 
 	resp, replacedBy, err := h.svc.ReassignReviewer(r.Context(), &req)
@@ -98,10 +115,24 @@ func (h *PRHandler) ReassignPR(w http.ResponseWriter, r *http.Request) {
 			writeError(w, http.StatusNotFound, "NOT_FOUND", err.Error())
 		case dto.ErrPRMerged:
 			writeError(w, http.StatusConflict, "PR_MERGED", err.Error())
+		case dto.ErrPRNotOpen:
+			writeError(w, http.StatusConflict, "PR_NOT_OPEN", err.Error())
 		case dto.ErrNotAssigned:
 			writeError(w, http.StatusConflict, "NOT_ASSIGNED", err.Error())
 		case dto.ErrNoCandidate:
 			writeError(w, http.StatusConflict, "NO_CANDIDATE", err.Error())
+		case dto.ErrReviewerInactive:
+			writeError(w, http.StatusConflict, "REVIEWER_INACTIVE", err.Error())
+		case dto.ErrReviewerAbsent:
+			writeError(w, http.StatusConflict, "REVIEWER_ABSENT", err.Error())
+		case dto.ErrReviewerIsAuthor:
+			writeError(w, http.StatusConflict, "REVIEWER_IS_AUTHOR", err.Error())
+		case dto.ErrAlreadyAssigned:
+			writeError(w, http.StatusConflict, "ALREADY_ASSIGNED", err.Error())
+		case dto.ErrTeamNotAllowed:
+			writeError(w, http.StatusConflict, "TEAM_NOT_ALLOWED", err.Error())
+		case dto.ErrReviewerAtLimit:
+			writeError(w, http.StatusConflict, "REVIEWER_AT_LIMIT", err.Error())
 		default:
 			writeError(w, http.StatusInternalServerError, "INTERNAL", err.Error())
 		}
@@ -118,3 +149,153 @@ func (h *PRHandler) ReassignPR(w http.ResponseWriter, r *http.Request) {
 		"replaced_by": replacedBy,
 	})
 }
+
+func (h *PRHandler) SubmitReview(w http.ResponseWriter, r *http.Request) {
+	var req pr.ReviewRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.svc.Logger().Error(r.Context(), "Failed to decode ReviewRequest", zap.Error(err))
+		writeError(w, http.StatusBadRequest, "INVALID_INPUT", err.Error())
+		return
+	}
+
+	h.svc.Logger().Info(r.Context(), "SubmitReview request received",
+		zap.String("pull_request_id", req.PullRequestID),
+		zap.String("user_id", req.UserID),
+		zap.String("verdict", req.Verdict),
+	)
+
+	resp, err := h.svc.SubmitReview(r.Context(), &req)
+	if err != nil {
+		h.svc.Logger().Error(r.Context(), "SubmitReview failed", zap.Error(err))
+		switch err {
+		case dto.ErrInvalidVerdict:
+			writeError(w, http.StatusBadRequest, "INVALID_INPUT", err.Error())
+		case dto.ErrNotFound:
+			writeError(w, http.StatusNotFound, "NOT_FOUND", err.Error())
+		case dto.ErrPRMerged:
+			writeError(w, http.StatusConflict, "PR_MERGED", err.Error())
+		case dto.ErrPRNotOpen:
+			writeError(w, http.StatusConflict, "PR_NOT_OPEN", err.Error())
+		case dto.ErrNotAssigned:
+			writeError(w, http.StatusConflict, "NOT_ASSIGNED", err.Error())
+		default:
+			writeError(w, http.StatusInternalServerError, "INTERNAL", err.Error())
+		}
+		return
+	}
+
+	h.svc.Logger().Info(r.Context(), "SubmitReview succeeded", zap.String("pull_request_id", resp.PullRequestID))
+	writeJSON(w, http.StatusOK, map[string]interface{}{"pr": resp})
+}
+
+func (h *PRHandler) ClosePR(w http.ResponseWriter, r *http.Request) {
+	h.changeStatus(w, r, "ClosePR", h.svc.ClosePR)
+}
+
+func (h *PRHandler) ReopenPR(w http.ResponseWriter, r *http.Request) {
+	h.changeStatus(w, r, "ReopenPR", h.svc.ReopenPR)
+}
+
+func (h *PRHandler) MarkReady(w http.ResponseWriter, r *http.Request) {
+	h.changeStatus(w, r, "MarkReady", h.svc.MarkReady)
+}
+
+func (h *PRHandler) changeStatus(w http.ResponseWriter, r *http.Request, op string,
+	fn func(ctx context.Context, req *pr.TransitionRequest) (*pr.PRResponse, error),
+) {
+	var req pr.TransitionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.svc.Logger().Error(r.Context(), "Failed to decode TransitionRequest", zap.String("op", op), zap.Error(err))
+		writeError(w, http.StatusBadRequest, "INVALID_INPUT", err.Error())
+		return
+	}
+
+	h.svc.Logger().Info(r.Context(), op+" request received", zap.String("pull_request_id", req.PullRequestID))
+
+	resp, err := fn(r.Context(), &req)
+	if err != nil {
+		h.svc.Logger().Error(r.Context(), op+" failed", zap.Error(err))
+		switch err {
+		case dto.ErrNotFound:
+			writeError(w, http.StatusNotFound, "NOT_FOUND", err.Error())
+		case dto.ErrInvalidTransition:
+			writeError(w, http.StatusConflict, "INVALID_TRANSITION", err.Error())
+		default:
+			writeError(w, http.StatusInternalServerError, "INTERNAL", err.Error())
+		}
+		return
+	}
+
+	h.svc.Logger().Info(r.Context(), op+" succeeded",
+		zap.String("pull_request_id", resp.PullRequestID),
+		zap.String("status", resp.Status),
+	)
+	writeJSON(w, http.StatusOK, map[string]interface{}{"pr": resp})
+}
+
+func (h *PRHandler) GetPR(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	prID := strings.TrimSpace(r.URL.Query().Get("pull_request_id"))
+	if prID == "" {
+		h.svc.Logger().Error(ctx, "GetPR missing pull_request_id")
+		writeError(w, http.StatusBadRequest, "INVALID_INPUT", "pull_request_id required")
+		return
+	}
+
+	resp, err := h.svc.GetPR(ctx, prID)
+	if err != nil {
+		h.svc.Logger().Error(ctx, "GetPR failed", zap.Error(err), zap.String("pull_request_id", prID))
+		switch err {
+		case dto.ErrNotFound:
+			writeError(w, http.StatusNotFound, "NOT_FOUND", err.Error())
+		default:
+			writeError(w, http.StatusInternalServerError, "INTERNAL", err.Error())
+		}
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"pr": resp})
+}
+
+func (h *PRHandler) ListPRs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	query := r.URL.Query()
+
+	var statuses []string
+	for _, value := range query["status"] {
+		for _, status := range strings.Split(value, ",") {
+			if status = strings.TrimSpace(status); status != "" {
+				statuses = append(statuses, status)
+			}
+		}
+	}
+
+	req := &pr.ListPRsRequest{
+		Statuses:    statuses,
+		AuthorID:    strings.TrimSpace(query.Get("author_id")),
+		ReviewerID:  strings.TrimSpace(query.Get("reviewer_id")),
+		TeamName:    strings.TrimSpace(query.Get("team_name")),
+		CreatedFrom: strings.TrimSpace(query.Get("created_from")),
+		CreatedTo:   strings.TrimSpace(query.Get("created_to")),
+		MergedFrom:  strings.TrimSpace(query.Get("merged_from")),
+		MergedTo:    strings.TrimSpace(query.Get("merged_to")),
+		SortBy:      strings.TrimSpace(query.Get("sort_by")),
+		Order:       strings.TrimSpace(query.Get("order")),
+		Limit:       strings.TrimSpace(query.Get("limit")),
+		Cursor:      strings.TrimSpace(query.Get("cursor")),
+	}
+
+	resp, err := h.svc.ListPRs(ctx, req)
+	if err != nil {
+		h.svc.Logger().Error(ctx, "ListPRs failed", zap.Error(err))
+		switch err {
+		case dto.ErrInvalidListQuery, dto.ErrInvalidTimeRange:
+			writeError(w, http.StatusBadRequest, "INVALID_INPUT", err.Error())
+		default:
+			writeError(w, http.StatusInternalServerError, "INTERNAL", err.Error())
+		}
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"pr_reviewer_assignment_service/internal/dto"
+	"pr_reviewer_assignment_service/internal/dto/stats"
+	usecase "pr_reviewer_assignment_service/internal/usecase/stats"
+
+	"go.uber.org/zap"
+)
+
+type StatsHandler struct {
+	svc *usecase.StatsService
+}
+
+func NewStatsHandler(svc *usecase.StatsService) *StatsHandler {
+	return &StatsHandler{svc: svc}
+}
+
+func statsRequest(r *http.Request) *stats.StatsRequest {
+	query := r.URL.Query()
+	return &stats.StatsRequest{
+		TeamName: strings.TrimSpace(query.Get("team_name")),
+		From:     strings.TrimSpace(query.Get("from")),
+		To:       strings.TrimSpace(query.Get("to")),
+	}
+}
+
+func (h *StatsHandler) GetReviewerStats(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	req := statsRequest(r)
+
+	resp, err := h.svc.GetReviewerStats(ctx, req)
+	if err != nil {
+		h.svc.Logger().Error(ctx, "GetReviewerStats failed", zap.Error(err), zap.String("team_name", req.TeamName))
+		switch err {
+		case dto.ErrInvalidTimeRange:
+			writeError(w, http.StatusBadRequest, "INVALID_INPUT", err.Error())
+		case dto.ErrNotFound:
+			writeError(w, http.StatusNotFound, "NOT_FOUND", err.Error())
+		default:
+			writeError(w, http.StatusInternalServerError, "INTERNAL", err.Error())
+		}
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (h *StatsHandler) GetFairness(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	teamName := strings.TrimSpace(r.URL.Query().Get("team_name"))
+
+	resp, err := h.svc.GetFairness(ctx, teamName)
+	if err != nil {
+		h.svc.Logger().Error(ctx, "GetFairness failed", zap.Error(err), zap.String("team_name", teamName))
+		switch err {
+		case dto.ErrNotFound:
+			writeError(w, http.StatusNotFound, "NOT_FOUND", err.Error())
+		default:
+			writeError(w, http.StatusInternalServerError, "INTERNAL", err.Error())
+		}
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (h *StatsHandler) GetLatency(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	req := statsRequest(r)
+
+	resp, err := h.svc.GetLatency(ctx, req)
+	if err != nil {
+		h.svc.Logger().Error(ctx, "GetLatency failed", zap.Error(err), zap.String("team_name", req.TeamName))
+		switch err {
+		case dto.ErrInvalidTimeRange:
+			writeError(w, http.StatusBadRequest, "INVALID_INPUT", err.Error())
+		case dto.ErrNotFound:
+			writeError(w, http.StatusNotFound, "NOT_FOUND", err.Error())
+		default:
+			writeError(w, http.StatusInternalServerError, "INTERNAL", err.Error())
+		}
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
@@ -35,6 +35,8 @@ func (h *TeamHandler) CreateTeam(w http.ResponseWriter, r *http.Request) {
 		switch err {
 		case dto.ErrTeamExists:
 			writeError(w, http.StatusConflict, "TEAM_EXISTS", err.Error())
+		case dto.ErrInvalidReviewersCount, dto.ErrInvalidMembership, dto.ErrInvalidCapacity:
+			writeError(w, http.StatusBadRequest, "INVALID_INPUT", err.Error())
 		default:
 			writeError(w, http.StatusInternalServerError, "INTERNAL", err.Error())
 		}
@@ -56,9 +58,11 @@ func (h *TeamHandler) GetTeam(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	h.svc.Logger().Info(ctx, "GetTeam request received", zap.String("team_name", teamName))
+	includeArchived := r.URL.Query().Get("include_archived") == "true"
 
-	resp, err := h.svc.GetTeamByName(ctx, teamName)
+	h.svc.Logger().Info(ctx, "GetTeam request received", zap.String("team_name", teamName), zap.Bool("include_archived", includeArchived))
+
+	resp, err := h.svc.GetTeamByName(ctx, teamName, includeArchived)
 	if err != nil {
 		h.svc.Logger().Error(ctx, "GetTeam failed", zap.Error(err), zap.String("team_name", teamName))
 		switch err {
@@ -74,3 +78,282 @@ func (h *TeamHandler) GetTeam(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(resp)
 }
+
+func (h *TeamHandler) UpdateTeam(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var req team.UpdateTeamRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.svc.Logger().Error(ctx, "failed to decode request", zap.Error(err))
+		writeError(w, http.StatusBadRequest, "INVALID_INPUT", err.Error())
+		return
+	}
+
+	h.svc.Logger().Info(ctx, "UpdateTeam request received", zap.String("team_name", req.TeamName))
+
+	resp, err := h.svc.UpdateTeam(ctx, &req)
+	if err != nil {
+		h.svc.Logger().Error(ctx, "UpdateTeam failed", zap.Error(err), zap.String("team_name", req.TeamName))
+		switch err {
+		case dto.ErrInvalidTeamUpdate, dto.ErrInvalidMembership:
+			writeError(w, http.StatusBadRequest, "INVALID_INPUT", err.Error())
+		case dto.ErrNotFound:
+			writeError(w, http.StatusNotFound, "NOT_FOUND", err.Error())
+		case dto.ErrNotTeamMember:
+			writeError(w, http.StatusNotFound, "NOT_TEAM_MEMBER", err.Error())
+		case dto.ErrTeamArchived:
+			writeError(w, http.StatusConflict, "TEAM_ARCHIVED", err.Error())
+		case dto.ErrReviewersChanged:
+			writeError(w, http.StatusConflict, "REVIEWERS_CHANGED", err.Error())
+		default:
+			writeError(w, http.StatusInternalServerError, "INTERNAL", err.Error())
+		}
+		return
+	}
+
+	h.svc.Logger().Info(ctx, "UpdateTeam succeeded", zap.String("team_name", req.TeamName))
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (h *TeamHandler) DeleteTeam(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var req team.DeleteTeamRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.svc.Logger().Error(ctx, "failed to decode request", zap.Error(err))
+		writeError(w, http.StatusBadRequest, "INVALID_INPUT", err.Error())
+		return
+	}
+
+	h.svc.Logger().Info(ctx, "DeleteTeam request received", zap.String("team_name", req.TeamName), zap.String("mode", req.Mode))
+
+	resp, err := h.svc.DeleteTeam(ctx, &req)
+	if err != nil {
+		h.svc.Logger().Error(ctx, "DeleteTeam failed", zap.Error(err), zap.String("team_name", req.TeamName))
+		switch err {
+		case dto.ErrInvalidDeleteMode:
+			writeError(w, http.StatusBadRequest, "INVALID_INPUT", err.Error())
+		case dto.ErrNotFound:
+			writeError(w, http.StatusNotFound, "NOT_FOUND", err.Error())
+		case dto.ErrTeamHasHistory:
+			writeError(w, http.StatusConflict, "TEAM_HAS_HISTORY", err.Error())
+		case dto.ErrTeamArchived:
+			writeError(w, http.StatusConflict, "TEAM_ARCHIVED", err.Error())
+		case dto.ErrReviewersChanged:
+			writeError(w, http.StatusConflict, "REVIEWERS_CHANGED", err.Error())
+		default:
+			writeError(w, http.StatusInternalServerError, "INTERNAL", err.Error())
+		}
+		return
+	}
+
+	h.svc.Logger().Info(ctx, "DeleteTeam succeeded", zap.String("team_name", req.TeamName), zap.String("mode", req.Mode))
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (h *TeamHandler) SetMembership(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var req team.SetMembershipRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.svc.Logger().Error(ctx, "failed to decode request", zap.Error(err))
+		writeError(w, http.StatusBadRequest, "INVALID_INPUT", err.Error())
+		return
+	}
+
+	h.svc.Logger().Info(ctx, "SetMembership request received", zap.String("team_name", req.TeamName), zap.String("user_id", req.UserID))
+
+	resp, err := h.svc.SetMembership(ctx, &req)
+	if err != nil {
+		h.svc.Logger().Error(ctx, "SetMembership failed", zap.Error(err), zap.String("team_name", req.TeamName))
+		switch err {
+		case dto.ErrInvalidMembership:
+			writeError(w, http.StatusBadRequest, "INVALID_INPUT", err.Error())
+		case dto.ErrNotTeamMember:
+			writeError(w, http.StatusNotFound, "NOT_TEAM_MEMBER", err.Error())
+		default:
+			writeError(w, http.StatusInternalServerError, "INTERNAL", err.Error())
+		}
+		return
+	}
+
+	h.svc.Logger().Info(ctx, "SetMembership succeeded", zap.String("team_name", req.TeamName), zap.String("user_id", req.UserID))
+	writeJSON(w, http.StatusOK, map[string]interface{}{"membership": resp})
+}
+
+func (h *TeamHandler) DeactivateUsers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var req team.DeactivateUsersRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.svc.Logger().Error(ctx, "failed to decode request", zap.Error(err))
+		writeError(w, http.StatusBadRequest, "INVALID_INPUT", err.Error())
+		return
+	}
+
+	h.svc.Logger().Info(ctx, "DeactivateUsers request received", zap.String("team_name", req.TeamName))
+
+	resp, err := h.svc.DeactivateMembers(ctx, &req)
+	if err != nil {
+		h.svc.Logger().Error(ctx, "DeactivateUsers failed", zap.Error(err), zap.String("team_name", req.TeamName))
+		switch err {
+		case dto.ErrInvalidDeactivate:
+			writeError(w, http.StatusBadRequest, "INVALID_INPUT", err.Error())
+		case dto.ErrNotFound:
+			writeError(w, http.StatusNotFound, "NOT_FOUND", err.Error())
+		case dto.ErrNotTeamMember:
+			writeError(w, http.StatusNotFound, "NOT_TEAM_MEMBER", err.Error())
+		case dto.ErrTeamArchived:
+			writeError(w, http.StatusConflict, "TEAM_ARCHIVED", err.Error())
+		case dto.ErrReviewersChanged:
+			writeError(w, http.StatusConflict, "REVIEWERS_CHANGED", err.Error())
+		default:
+			writeError(w, http.StatusInternalServerError, "INTERNAL", err.Error())
+		}
+		return
+	}
+
+	h.svc.Logger().Info(ctx, "DeactivateUsers succeeded", zap.String("team_name", req.TeamName), zap.Int("deactivated", len(resp.Deactivated)))
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (h *TeamHandler) SetMaxOpenReviews(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var req team.SetMaxOpenReviewsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.svc.Logger().Error(ctx, "failed to decode request", zap.Error(err))
+		writeError(w, http.StatusBadRequest, "INVALID_INPUT", err.Error())
+		return
+	}
+
+	h.svc.Logger().Info(ctx, "SetMaxOpenReviews request received", zap.String("team_name", req.TeamName))
+
+	resp, err := h.svc.SetMaxOpenReviews(ctx, &req)
+	if err != nil {
+		h.svc.Logger().Error(ctx, "SetMaxOpenReviews failed", zap.Error(err), zap.String("team_name", req.TeamName))
+		switch err {
+		case dto.ErrInvalidCapacity:
+			writeError(w, http.StatusBadRequest, "INVALID_INPUT", err.Error())
+		case dto.ErrNotFound:
+			writeError(w, http.StatusNotFound, "NOT_FOUND", err.Error())
+		case dto.ErrTeamArchived:
+			writeError(w, http.StatusConflict, "TEAM_ARCHIVED", err.Error())
+		default:
+			writeError(w, http.StatusInternalServerError, "INTERNAL", err.Error())
+		}
+		return
+	}
+
+	h.svc.Logger().Info(ctx, "SetMaxOpenReviews succeeded", zap.String("team_name", req.TeamName))
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (h *TeamHandler) SetFallbackTeams(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var req team.FallbackTeamsDTO
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.svc.Logger().Error(ctx, "failed to decode request", zap.Error(err))
+		writeError(w, http.StatusBadRequest, "INVALID_INPUT", err.Error())
+		return
+	}
+
+	h.svc.Logger().Info(ctx, "SetFallbackTeams request received", zap.String("team_name", req.TeamName))
+
+	resp, err := h.svc.SetFallbackTeams(ctx, &req)
+	if err != nil {
+		h.svc.Logger().Error(ctx, "SetFallbackTeams failed", zap.Error(err), zap.String("team_name", req.TeamName))
+		switch err {
+		case dto.ErrInvalidFallback:
+			writeError(w, http.StatusBadRequest, "INVALID_INPUT", err.Error())
+		case dto.ErrNotFound:
+			writeError(w, http.StatusNotFound, "NOT_FOUND", err.Error())
+		case dto.ErrTeamArchived:
+			writeError(w, http.StatusConflict, "TEAM_ARCHIVED", err.Error())
+		default:
+			writeError(w, http.StatusInternalServerError, "INTERNAL", err.Error())
+		}
+		return
+	}
+
+	h.svc.Logger().Info(ctx, "SetFallbackTeams succeeded", zap.String("team_name", req.TeamName))
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (h *TeamHandler) GetFallbackTeams(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	teamName := r.URL.Query().Get("team_name")
+	if teamName == "" {
+		h.svc.Logger().Error(ctx, "GetFallbackTeams missing team_name")
+		writeError(w, http.StatusBadRequest, "INVALID_INPUT", "team_name required")
+		return
+	}
+
+	h.svc.Logger().Info(ctx, "GetFallbackTeams request received", zap.String("team_name", teamName))
+
+	resp, err := h.svc.GetFallbackTeams(ctx, teamName)
+	if err != nil {
+		h.svc.Logger().Error(ctx, "GetFallbackTeams failed", zap.Error(err), zap.String("team_name", teamName))
+		switch err {
+		case dto.ErrNotFound:
+			writeError(w, http.StatusNotFound, "NOT_FOUND", err.Error())
+		default:
+			writeError(w, http.StatusInternalServerError, "INTERNAL", err.Error())
+		}
+		return
+	}
+
+	h.svc.Logger().Info(ctx, "GetFallbackTeams succeeded", zap.String("team_name", teamName))
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (h *TeamHandler) SetMergePolicy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var req team.MergePolicyDTO
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.svc.Logger().Error(ctx, "failed to decode request", zap.Error(err))
+		writeError(w, http.StatusBadRequest, "INVALID_INPUT", err.Error())
+		return
+	}
+
+	h.svc.Logger().Info(ctx, "SetMergePolicy request received", zap.String("team_name", req.TeamName))
+
+	resp, err := h.svc.SetMergePolicy(ctx, &req)
+	if err != nil {
+		h.svc.Logger().Error(ctx, "SetMergePolicy failed", zap.Error(err), zap.String("team_name", req.TeamName))
+		switch err {
+		case dto.ErrInvalidPolicy:
+			writeError(w, http.StatusBadRequest, "INVALID_INPUT", err.Error())
+		case dto.ErrNotFound:
+			writeError(w, http.StatusNotFound, "NOT_FOUND", err.Error())
+		default:
+			writeError(w, http.StatusInternalServerError, "INTERNAL", err.Error())
+		}
+		return
+	}
+
+	h.svc.Logger().Info(ctx, "SetMergePolicy succeeded", zap.String("team_name", req.TeamName))
+	writeJSON(w, http.StatusOK, map[string]interface{}{"policy": resp})
+}
+
+func (h *TeamHandler) GetMergePolicy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	teamName := r.URL.Query().Get("team_name")
+	if teamName == "" {
+		h.svc.Logger().Error(ctx, "GetMergePolicy missing team_name")
+		writeError(w, http.StatusBadRequest, "INVALID_INPUT", "team_name required")
+		return
+	}
+
+	h.svc.Logger().Info(ctx, "GetMergePolicy request received", zap.String("team_name", teamName))
+
+	resp, err := h.svc.GetMergePolicy(ctx, teamName)
+	if err != nil {
+		h.svc.Logger().Error(ctx, "GetMergePolicy failed", zap.Error(err), zap.String("team_name", teamName))
+		switch err {
+		case dto.ErrNotFound:
+			writeError(w, http.StatusNotFound, "NOT_FOUND", err.Error())
+		default:
+			writeError(w, http.StatusInternalServerError, "INTERNAL", err.Error())
+		}
+		return
+	}
+
+	h.svc.Logger().Info(ctx, "GetMergePolicy succeeded", zap.String("team_name", teamName))
+	writeJSON(w, http.StatusOK, map[string]interface{}{"policy": resp})
+}
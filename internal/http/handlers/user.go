@@ -31,6 +31,26 @@ func (h *UserHandler) SetActive(w http.ResponseWriter, r *http.Request) {
 
 	h.svc.Logger().Info(ctx, "SetActive request received", zap.String("user_id", req.UserID), zap.Bool("is_active", req.IsActive))
 
+	if !req.IsActive && req.ReassignOpenReviews {
+		resp, err := h.svc.Deactivate(ctx, &req)
+		if err != nil {
+			h.svc.Logger().Error(ctx, "Deactivate failed", zap.Error(err), zap.String("user_id", req.UserID))
+			switch err {
+			case dto.ErrNotFound:
+				writeError(w, http.StatusNotFound, "NOT_FOUND", err.Error())
+			case dto.ErrReviewersChanged:
+				writeError(w, http.StatusConflict, "REVIEWERS_CHANGED", err.Error())
+			default:
+				writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+			}
+			return
+		}
+
+		h.svc.Logger().Info(ctx, "Deactivate succeeded", zap.String("user_id", req.UserID), zap.Int("reassignments", len(resp.Reassignments)))
+		writeJSON(w, http.StatusOK, resp)
+		return
+	}
+
 	resp, err := h.svc.SetActive(ctx, &req)
 	if err != nil {
 		h.svc.Logger().Error(ctx, "SetActive failed", zap.Error(err), zap.String("user_id", req.UserID))
@@ -47,6 +67,194 @@ func (h *UserHandler) SetActive(w http.ResponseWriter, r *http.Request) {
 	writeJSON(w, http.StatusOK, map[string]interface{}{"user": resp})
 }
 
+func (h *UserHandler) SetMaxOpenReviews(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var req user.SetMaxOpenReviewsRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.svc.Logger().Error(ctx, "failed to decode SetMaxOpenReviews request", zap.Error(err))
+		writeError(w, http.StatusBadRequest, "BAD_REQUEST", err.Error())
+		return
+	}
+
+	h.svc.Logger().Info(ctx, "SetMaxOpenReviews request received", zap.String("user_id", req.UserID))
+
+	resp, err := h.svc.SetMaxOpenReviews(ctx, &req)
+	if err != nil {
+		h.svc.Logger().Error(ctx, "SetMaxOpenReviews failed", zap.Error(err), zap.String("user_id", req.UserID))
+		switch err {
+		case dto.ErrInvalidCapacity:
+			writeError(w, http.StatusBadRequest, "BAD_REQUEST", err.Error())
+		case dto.ErrNotFound:
+			writeError(w, http.StatusNotFound, "NOT_FOUND", err.Error())
+		default:
+			writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+		}
+		return
+	}
+
+	h.svc.Logger().Info(ctx, "SetMaxOpenReviews succeeded", zap.String("user_id", req.UserID))
+	writeJSON(w, http.StatusOK, map[string]interface{}{"user": resp})
+}
+
+func (h *UserHandler) SetOwnershipPatterns(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var req user.OwnershipPatternsDTO
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.svc.Logger().Error(ctx, "failed to decode SetOwnershipPatterns request", zap.Error(err))
+		writeError(w, http.StatusBadRequest, "BAD_REQUEST", err.Error())
+		return
+	}
+
+	h.svc.Logger().Info(ctx, "SetOwnershipPatterns request received", zap.String("user_id", req.UserID))
+
+	resp, err := h.svc.SetOwnershipPatterns(ctx, &req)
+	if err != nil {
+		h.svc.Logger().Error(ctx, "SetOwnershipPatterns failed", zap.Error(err), zap.String("user_id", req.UserID))
+		switch err {
+		case dto.ErrInvalidOwnership:
+			writeError(w, http.StatusBadRequest, "BAD_REQUEST", err.Error())
+		case dto.ErrNotFound:
+			writeError(w, http.StatusNotFound, "NOT_FOUND", err.Error())
+		default:
+			writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+		}
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (h *UserHandler) GetOwnershipPatterns(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID := strings.TrimSpace(r.URL.Query().Get("user_id"))
+	if userID == "" {
+		h.svc.Logger().Error(ctx, "GetOwnershipPatterns missing user_id")
+		writeError(w, http.StatusBadRequest, "BAD_REQUEST", "user_id required")
+		return
+	}
+
+	resp, err := h.svc.GetOwnershipPatterns(ctx, userID)
+	if err != nil {
+		h.svc.Logger().Error(ctx, "GetOwnershipPatterns failed", zap.Error(err), zap.String("user_id", userID))
+		switch err {
+		case dto.ErrNotFound:
+			writeError(w, http.StatusNotFound, "NOT_FOUND", err.Error())
+		default:
+			writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+		}
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (h *UserHandler) ImportCodeowners(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var req user.ImportCodeownersRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.svc.Logger().Error(ctx, "failed to decode ImportCodeowners request", zap.Error(err))
+		writeError(w, http.StatusBadRequest, "BAD_REQUEST", err.Error())
+		return
+	}
+
+	resp, err := h.svc.ImportCodeowners(ctx, &req)
+	if err != nil {
+		h.svc.Logger().Error(ctx, "ImportCodeowners failed", zap.Error(err))
+		switch err {
+		case dto.ErrInvalidCodeowners:
+			writeError(w, http.StatusBadRequest, "BAD_REQUEST", err.Error())
+		default:
+			writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+		}
+		return
+	}
+
+	h.svc.Logger().Info(ctx, "ImportCodeowners succeeded", zap.Int("rules_imported", resp.RulesImported))
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (h *UserHandler) AddAbsence(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var req user.AddAbsenceRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.svc.Logger().Error(ctx, "failed to decode AddAbsence request", zap.Error(err))
+		writeError(w, http.StatusBadRequest, "BAD_REQUEST", err.Error())
+		return
+	}
+
+	h.svc.Logger().Info(ctx, "AddAbsence request received", zap.String("user_id", req.UserID))
+
+	resp, err := h.svc.AddAbsence(ctx, &req)
+	if err != nil {
+		h.svc.Logger().Error(ctx, "AddAbsence failed", zap.Error(err), zap.String("user_id", req.UserID))
+		switch err {
+		case dto.ErrInvalidAbsence:
+			writeError(w, http.StatusBadRequest, "BAD_REQUEST", err.Error())
+		case dto.ErrNotFound:
+			writeError(w, http.StatusNotFound, "NOT_FOUND", err.Error())
+		default:
+			writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+		}
+		return
+	}
+
+	h.svc.Logger().Info(ctx, "AddAbsence succeeded", zap.String("user_id", req.UserID))
+	writeJSON(w, http.StatusCreated, map[string]interface{}{"absence": resp})
+}
+
+func (h *UserHandler) GetAbsences(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID := strings.TrimSpace(r.URL.Query().Get("user_id"))
+	if userID == "" {
+		h.svc.Logger().Error(ctx, "GetAbsences missing user_id")
+		writeError(w, http.StatusBadRequest, "BAD_REQUEST", "user_id required")
+		return
+	}
+
+	resp, err := h.svc.GetAbsences(ctx, userID)
+	if err != nil {
+		h.svc.Logger().Error(ctx, "GetAbsences failed", zap.Error(err), zap.String("user_id", userID))
+		switch err {
+		case dto.ErrNotFound:
+			writeError(w, http.StatusNotFound, "NOT_FOUND", err.Error())
+		default:
+			writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+		}
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (h *UserHandler) DeleteAbsence(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var req user.DeleteAbsenceRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.svc.Logger().Error(ctx, "failed to decode DeleteAbsence request", zap.Error(err))
+		writeError(w, http.StatusBadRequest, "BAD_REQUEST", err.Error())
+		return
+	}
+
+	if err := h.svc.DeleteAbsence(ctx, &req); err != nil {
+		h.svc.Logger().Error(ctx, "DeleteAbsence failed", zap.Error(err), zap.Int64("absence_id", req.AbsenceID))
+		switch err {
+		case dto.ErrNotFound:
+			writeError(w, http.StatusNotFound, "NOT_FOUND", err.Error())
+		default:
+			writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+		}
+		return
+	}
+
+	h.svc.Logger().Info(ctx, "DeleteAbsence succeeded", zap.Int64("absence_id", req.AbsenceID))
+	writeJSON(w, http.StatusOK, map[string]interface{}{"absence_id": req.AbsenceID})
+}
+
 func (h *UserHandler) GetReview(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	userID := strings.TrimSpace(r.URL.Query().Get("user_id"))
@@ -74,6 +282,42 @@ func (h *UserHandler) GetReview(w http.ResponseWriter, r *http.Request) {
 	writeJSON(w, http.StatusOK, resp)
 }
 
+func (h *UserHandler) MoveUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var req user.MoveUserRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.svc.Logger().Error(ctx, "failed to decode MoveUser request", zap.Error(err))
+		writeError(w, http.StatusBadRequest, "BAD_REQUEST", err.Error())
+		return
+	}
+
+	h.svc.Logger().Info(ctx, "MoveUser request received", zap.String("user_id", req.UserID), zap.String("team_name", req.TeamName))
+
+	resp, err := h.svc.MoveUser(ctx, &req)
+	if err != nil {
+		h.svc.Logger().Error(ctx, "MoveUser failed", zap.Error(err), zap.String("user_id", req.UserID))
+		switch err {
+		case dto.ErrInvalidMoveMode:
+			writeError(w, http.StatusBadRequest, "BAD_REQUEST", err.Error())
+		case dto.ErrNotFound:
+			writeError(w, http.StatusNotFound, "NOT_FOUND", err.Error())
+		case dto.ErrSameTeam:
+			writeError(w, http.StatusConflict, "SAME_TEAM", err.Error())
+		case dto.ErrTeamArchived:
+			writeError(w, http.StatusConflict, "TEAM_ARCHIVED", err.Error())
+		case dto.ErrReviewersChanged:
+			writeError(w, http.StatusConflict, "REVIEWERS_CHANGED", err.Error())
+		default:
+			writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+		}
+		return
+	}
+
+	h.svc.Logger().Info(ctx, "MoveUser succeeded", zap.String("user_id", req.UserID), zap.String("team_name", req.TeamName))
+	writeJSON(w, http.StatusOK, resp)
+}
+
 func writeJSON(w http.ResponseWriter, status int, data interface{}) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(status)
@@ -0,0 +1 @@
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS max_reviewers INT CHECK (max_reviewers >= 0);
@@ -0,0 +1,3 @@
+ALTER TABLE pull_request_reviewers
+    DROP COLUMN IF EXISTS verdict_at,
+    DROP COLUMN IF EXISTS verdict;
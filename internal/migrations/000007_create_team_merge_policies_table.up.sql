@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS team_merge_policies (
+    team_name TEXT PRIMARY KEY REFERENCES teams(team_name) ON DELETE CASCADE,
+    min_approvals INT NOT NULL DEFAULT 0 CHECK (min_approvals >= 0),
+    block_on_changes_requested BOOLEAN NOT NULL DEFAULT FALSE,
+    forbid_author_only_approval BOOLEAN NOT NULL DEFAULT FALSE,
+    required_reviewer_team TEXT REFERENCES teams(team_name) ON DELETE SET NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS user_team_moves (
+    move_id BIGSERIAL PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    from_team TEXT NOT NULL,
+    to_team TEXT NOT NULL,
+    moved_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_team_moves_user_id ON user_team_moves(user_id, moved_at);
@@ -0,0 +1,2 @@
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS team_name;
+DROP TABLE IF EXISTS team_memberships;
@@ -0,0 +1,3 @@
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS under_staffed;
+ALTER TABLE teams DROP COLUMN IF EXISTS max_open_reviews;
+ALTER TABLE users DROP COLUMN IF EXISTS max_open_reviews;
@@ -0,0 +1,3 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS max_open_reviews INT CHECK (max_open_reviews >= 0);
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS max_open_reviews INT CHECK (max_open_reviews >= 0);
+ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS under_staffed BOOLEAN NOT NULL DEFAULT FALSE;
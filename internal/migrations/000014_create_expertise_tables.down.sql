@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS user_ownership_patterns;
+DROP TABLE IF EXISTS pull_request_files;
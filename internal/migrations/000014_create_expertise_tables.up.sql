@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS pull_request_files (
+    pull_request_id UUID NOT NULL,
+    path TEXT NOT NULL,
+    PRIMARY KEY (pull_request_id, path),
+    CONSTRAINT fk_prf_pr FOREIGN KEY (pull_request_id) REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS user_ownership_patterns (
+    user_id UUID NOT NULL,
+    pattern TEXT NOT NULL,
+    position INT NOT NULL,
+    PRIMARY KEY (user_id, pattern),
+    CONSTRAINT fk_uop_user FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE
+);
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS codeowners_rules (
+    position INT PRIMARY KEY,
+    line INT NOT NULL,
+    pattern TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS codeowners_rule_owners (
+    position INT NOT NULL REFERENCES codeowners_rules(position) ON DELETE CASCADE,
+    user_id UUID REFERENCES users(user_id) ON DELETE CASCADE,
+    team_name TEXT REFERENCES teams(team_name) ON DELETE CASCADE,
+    CHECK ((user_id IS NULL) <> (team_name IS NULL))
+);
+
+CREATE INDEX IF NOT EXISTS idx_codeowners_rule_owners_position ON codeowners_rule_owners(position);
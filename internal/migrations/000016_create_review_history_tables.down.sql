@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_pull_requests_status_merged_at;
+DROP TABLE IF EXISTS review_reassignments;
+DROP TABLE IF EXISTS review_assignments;
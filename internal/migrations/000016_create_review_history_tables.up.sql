@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS review_assignments (
+    assignment_id BIGSERIAL PRIMARY KEY,
+    pull_request_id UUID NOT NULL REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    assigned_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_review_assignments_user ON review_assignments(user_id, assigned_at);
+
+INSERT INTO review_assignments (pull_request_id, user_id, assigned_at)
+SELECT pull_request_id, user_id, assigned_at FROM pull_request_reviewers;
+
+CREATE TABLE IF NOT EXISTS review_reassignments (
+    reassignment_id BIGSERIAL PRIMARY KEY,
+    pull_request_id UUID NOT NULL REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+    from_user_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    to_user_id UUID REFERENCES users(user_id) ON DELETE SET NULL,
+    reassigned_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_review_reassignments_from_user ON review_reassignments(from_user_id, reassigned_at);
+
+CREATE INDEX IF NOT EXISTS idx_pull_requests_status_merged_at ON pull_requests(status, merged_at);
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS team_imbalance_events (
+    event_id BIGSERIAL PRIMARY KEY,
+    team_name TEXT NOT NULL REFERENCES teams(team_name) ON DELETE CASCADE,
+    imbalanced BOOLEAN NOT NULL,
+    gini DOUBLE PRECISION NOT NULL,
+    threshold DOUBLE PRECISION NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_team_imbalance_events_team ON team_imbalance_events(team_name, event_id);
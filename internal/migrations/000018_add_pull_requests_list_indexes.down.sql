@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_pull_requests_team_created;
+DROP INDEX IF EXISTS idx_pull_requests_author_created;
+DROP INDEX IF EXISTS idx_pull_requests_status_created;
+DROP INDEX IF EXISTS idx_pull_requests_merged;
+DROP INDEX IF EXISTS idx_pull_requests_created;
+
+ALTER TABLE pull_requests ALTER COLUMN created_at DROP NOT NULL;
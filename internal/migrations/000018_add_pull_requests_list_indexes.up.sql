@@ -0,0 +1,8 @@
+UPDATE pull_requests SET created_at = COALESCE(merged_at, now()) WHERE created_at IS NULL;
+ALTER TABLE pull_requests ALTER COLUMN created_at SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_pull_requests_created ON pull_requests(created_at, pull_request_id);
+CREATE INDEX IF NOT EXISTS idx_pull_requests_merged ON pull_requests(merged_at, pull_request_id) WHERE merged_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_pull_requests_status_created ON pull_requests(status, created_at, pull_request_id);
+CREATE INDEX IF NOT EXISTS idx_pull_requests_author_created ON pull_requests(author_id, created_at, pull_request_id);
+CREATE INDEX IF NOT EXISTS idx_pull_requests_team_created ON pull_requests(team_name, created_at, pull_request_id);
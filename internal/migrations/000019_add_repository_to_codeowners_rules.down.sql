@@ -0,0 +1,16 @@
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS repository;
+
+DELETE FROM codeowners_rules WHERE repository <> '';
+
+DROP INDEX IF EXISTS idx_codeowners_rule_owners_rule;
+ALTER TABLE codeowners_rule_owners DROP CONSTRAINT IF EXISTS fk_cro_rule;
+ALTER TABLE codeowners_rule_owners DROP COLUMN IF EXISTS repository;
+
+ALTER TABLE codeowners_rules DROP CONSTRAINT IF EXISTS codeowners_rules_pkey;
+ALTER TABLE codeowners_rules DROP COLUMN IF EXISTS repository;
+ALTER TABLE codeowners_rules ADD PRIMARY KEY (position);
+
+ALTER TABLE codeowners_rule_owners
+    ADD CONSTRAINT codeowners_rule_owners_position_fkey FOREIGN KEY (position)
+    REFERENCES codeowners_rules(position) ON DELETE CASCADE;
+CREATE INDEX IF NOT EXISTS idx_codeowners_rule_owners_position ON codeowners_rule_owners(position);
@@ -0,0 +1,15 @@
+ALTER TABLE codeowners_rule_owners DROP CONSTRAINT IF EXISTS codeowners_rule_owners_position_fkey;
+DROP INDEX IF EXISTS idx_codeowners_rule_owners_position;
+
+ALTER TABLE codeowners_rules ADD COLUMN IF NOT EXISTS repository TEXT NOT NULL DEFAULT '';
+ALTER TABLE codeowners_rules DROP CONSTRAINT IF EXISTS codeowners_rules_pkey;
+ALTER TABLE codeowners_rules ADD PRIMARY KEY (repository, position);
+
+ALTER TABLE codeowners_rule_owners ADD COLUMN IF NOT EXISTS repository TEXT NOT NULL DEFAULT '';
+ALTER TABLE codeowners_rule_owners
+    ADD CONSTRAINT fk_cro_rule FOREIGN KEY (repository, position)
+    REFERENCES codeowners_rules(repository, position) ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS idx_codeowners_rule_owners_rule ON codeowners_rule_owners(repository, position);
+
+ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS repository TEXT NOT NULL DEFAULT '';
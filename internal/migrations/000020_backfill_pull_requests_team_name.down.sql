@@ -0,0 +1,2 @@
+-- Команда PR, заполненная по автору, неотличима от указанной при создании,
+-- поэтому откат оставляет team_name как есть.
@@ -0,0 +1,5 @@
+UPDATE pull_requests pr
+SET team_name = u.team_name
+FROM users u
+WHERE pr.team_name IS NULL
+  AND u.user_id = pr.author_id;
@@ -0,0 +1,194 @@
+package postgres
+
+import (
+	"context"
+	"slices"
+
+	"pr_reviewer_assignment_service/internal/entity"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+// GetIDsByUsernames возвращает ID пользователей по именам. Имена не
+// уникальны, поэтому одному имени может соответствовать несколько ID.
+func (r *UserRepository) GetIDsByUsernames(ctx context.Context, usernames []string) (map[string][]string, error) {
+	result := make(map[string][]string)
+	if len(usernames) == 0 {
+		return result, nil
+	}
+
+	sqlStr, args, err := r.sb.Select("username", "user_id").
+		From("users").
+		Where(sq.Eq{"username": usernames}).
+		OrderBy("username", "user_id").
+		ToSql()
+	if err != nil {
+		r.logger.Error(ctx, "Failed to build GetIDsByUsernames query", zap.Error(err))
+		return nil, err
+	}
+
+	var rows []struct {
+		Username string `db:"username"`
+		UserID   string `db:"user_id"`
+	}
+	if err := r.db.SelectContext(ctx, &rows, sqlStr, args...); err != nil {
+		r.logger.Error(ctx, "Failed to fetch users by usernames", zap.Error(err))
+		return nil, err
+	}
+
+	for _, row := range rows {
+		result[row.Username] = append(result[row.Username], row.UserID)
+	}
+	return result, nil
+}
+
+// ExistingTeams возвращает те из names, для которых есть команда.
+func (r *UserRepository) ExistingTeams(ctx context.Context, names []string) ([]string, error) {
+	teams := []string{}
+	if len(names) == 0 {
+		return teams, nil
+	}
+
+	sqlStr, args, err := r.sb.Select("team_name").
+		From("teams").
+		Where(sq.Eq{"team_name": names}).
+		OrderBy("team_name").
+		ToSql()
+	if err != nil {
+		r.logger.Error(ctx, "Failed to build ExistingTeams query", zap.Error(err))
+		return nil, err
+	}
+
+	if err := r.db.SelectContext(ctx, &teams, sqlStr, args...); err != nil {
+		r.logger.Error(ctx, "Failed to fetch teams", zap.Error(err))
+		return nil, err
+	}
+	return teams, nil
+}
+
+// ReplaceCodeownersRules заменяет импортированные правила CODEOWNERS
+// репозитория repository; правила других репозиториев не затрагиваются.
+func (r *UserRepository) ReplaceCodeownersRules(ctx context.Context, repository string, rules []entity.CodeownersRule) error {
+	r.logger.Info(ctx, "Replacing CODEOWNERS rules", zap.String("repository", repository), zap.Int("rules_count", len(rules)))
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		r.logger.Error(ctx, "Failed to begin transaction", zap.Error(err))
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if _, err = tx.ExecContext(ctx, `DELETE FROM codeowners_rules WHERE repository=$1`, repository); err != nil {
+		r.logger.Error(ctx, "Failed to delete CODEOWNERS rules", zap.Error(err))
+		return err
+	}
+
+	var positions, lines, userPositions, teamPositions []int64
+	var patterns, userIDs, teams []string
+	for _, rule := range rules {
+		positions = append(positions, int64(rule.Position))
+		lines = append(lines, int64(rule.Line))
+		patterns = append(patterns, rule.Pattern)
+		for _, userID := range rule.UserIDs {
+			userPositions = append(userPositions, int64(rule.Position))
+			userIDs = append(userIDs, userID)
+		}
+		for _, team := range rule.Teams {
+			teamPositions = append(teamPositions, int64(rule.Position))
+			teams = append(teams, team)
+		}
+	}
+
+	if len(rules) > 0 {
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO codeowners_rules (repository, position, line, pattern)
+			SELECT $1, * FROM unnest($2::int[], $3::int[], $4::text[])
+		`, repository, pq.Array(positions), pq.Array(lines), pq.Array(patterns))
+		if err != nil {
+			r.logger.Error(ctx, "Failed to insert CODEOWNERS rules", zap.Error(err))
+			return err
+		}
+	}
+
+	if len(userIDs) > 0 || len(teams) > 0 {
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO codeowners_rule_owners (repository, position, user_id, team_name)
+			SELECT $1, position, user_id, NULL FROM unnest($2::int[], $3::uuid[]) AS o(position, user_id)
+			UNION ALL
+			SELECT $1, position, NULL, team_name FROM unnest($4::int[], $5::text[]) AS o(position, team_name)
+		`, repository, pq.Array(userPositions), pq.Array(userIDs), pq.Array(teamPositions), pq.Array(teams))
+		if err != nil {
+			r.logger.Error(ctx, "Failed to insert CODEOWNERS owners", zap.Error(err))
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.logger.Error(ctx, "Failed to commit CODEOWNERS rules", zap.Error(err))
+		return err
+	}
+
+	r.logger.Info(ctx, "CODEOWNERS rules replaced", zap.String("repository", repository), zap.Int("rules_count", len(rules)))
+	return nil
+}
+
+// GetCodeownersRules возвращает импортированные правила CODEOWNERS
+// репозитория repository по порядку. Командные владельцы раскрываются в
+// участников команд.
+func (r *UserRepository) GetCodeownersRules(ctx context.Context, repository string) ([]entity.CodeownersRule, error) {
+	rules := []entity.CodeownersRule{}
+	if err := r.db.SelectContext(ctx, &rules, `
+		SELECT position, line, pattern FROM codeowners_rules WHERE repository=$1 ORDER BY position
+	`, repository); err != nil {
+		r.logger.Error(ctx, "Failed to fetch CODEOWNERS rules", zap.Error(err))
+		return nil, err
+	}
+	if len(rules) == 0 {
+		return rules, nil
+	}
+
+	var owners []struct {
+		Position int     `db:"position"`
+		UserID   string  `db:"user_id"`
+		TeamName *string `db:"team_name"`
+	}
+	if err := r.db.SelectContext(ctx, &owners, `
+		SELECT o.position, o.user_id, NULL AS team_name
+		FROM codeowners_rule_owners o
+		WHERE o.repository = $1 AND o.user_id IS NOT NULL
+		UNION
+		SELECT o.position, m.user_id, o.team_name
+		FROM codeowners_rule_owners o
+		JOIN team_memberships m ON m.team_name = o.team_name
+		WHERE o.repository = $1
+		ORDER BY 1, 2
+	`, repository); err != nil {
+		r.logger.Error(ctx, "Failed to fetch CODEOWNERS owners", zap.Error(err))
+		return nil, err
+	}
+
+	byPosition := make(map[int]*entity.CodeownersRule, len(rules))
+	for i := range rules {
+		byPosition[rules[i].Position] = &rules[i]
+	}
+	for _, owner := range owners {
+		rule := byPosition[owner.Position]
+		if rule == nil {
+			continue
+		}
+		if !slices.Contains(rule.UserIDs, owner.UserID) {
+			rule.UserIDs = append(rule.UserIDs, owner.UserID)
+		}
+		if owner.TeamName != nil && !slices.Contains(rule.Teams, *owner.TeamName) {
+			rule.Teams = append(rule.Teams, *owner.TeamName)
+		}
+	}
+
+	return rules, nil
+}
@@ -0,0 +1,252 @@
+package postgres
+
+import (
+	"context"
+
+	"pr_reviewer_assignment_service/internal/dto"
+	"pr_reviewer_assignment_service/internal/entity"
+	"pr_reviewer_assignment_service/pkg/logger"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+// deactivateWithReassignments в транзакции tx выключает пользователей userIDs
+// и применяет к ещё открытым PR план переназначений их ревью. Возвращает
+// применённую часть плана.
+func deactivateWithReassignments(
+	ctx context.Context,
+	tx *sqlx.Tx,
+	log logger.Logger,
+	userIDs []string,
+	plan []entity.Reassignment,
+) ([]entity.Reassignment, error) {
+	res, err := tx.ExecContext(ctx,
+		"UPDATE users SET is_active = FALSE WHERE user_id = ANY($1::uuid[])",
+		pq.Array(userIDs),
+	)
+	if err != nil {
+		log.Error(ctx, "Failed to deactivate users", zap.Error(err))
+		return nil, err
+	}
+	if n, _ := res.RowsAffected(); n < int64(len(userIDs)) {
+		log.Warn(ctx, "Some users not found when deactivating", zap.Int("requested", len(userIDs)), zap.Int64("updated", n))
+		return nil, dto.ErrNotFound
+	}
+
+	return applyReassignments(ctx, tx, log, plan, false)
+}
+
+// applyReassignments в транзакции tx применяет к ещё открытым PR план
+// переназначений. Все изменения выполняются несколькими запросами по
+// массивам, без цикла по PR. Пункты по PR, которые успели закрыть или
+// смержить, и по уже снятым ревьюверам отбрасываются. Если замена к этому
+// моменту уже стоит на PR, пункт считается пунктом без замены. Пункты без
+// замены при release снимают ревьювера (Released), иначе ревью остаётся за
+// ним. Если состав ревьюверов изменился или запланированная замена стала
+// недоступна, возвращается dto.ErrReviewersChanged и транзакция
+// откатывается целиком. Возвращает применённую часть плана.
+func applyReassignments(
+	ctx context.Context,
+	tx *sqlx.Tx,
+	log logger.Logger,
+	plan []entity.Reassignment,
+	release bool,
+) ([]entity.Reassignment, error) {
+	applied := []entity.Reassignment{}
+	if len(plan) == 0 {
+		return applied, nil
+	}
+
+	prIDs := make([]string, 0, len(plan))
+	for _, r := range plan {
+		prIDs = append(prIDs, r.PullRequestID)
+	}
+
+	var openIDs []string
+	err := tx.SelectContext(ctx, &openIDs, `
+		SELECT pull_request_id FROM pull_requests
+		WHERE pull_request_id = ANY($1::uuid[]) AND status = $2
+		ORDER BY pull_request_id
+		FOR UPDATE
+	`, pq.Array(prIDs), entity.StatusOpen)
+	if err != nil {
+		log.Error(ctx, "Failed to lock open PRs", zap.Error(err))
+		return nil, err
+	}
+	if len(openIDs) == 0 {
+		return applied, nil
+	}
+	open := make(map[string]bool, len(openIDs))
+	for _, id := range openIDs {
+		open[id] = true
+	}
+
+	var rows []struct {
+		PullRequestID string `db:"pull_request_id"`
+		UserID        string `db:"user_id"`
+	}
+	err = tx.SelectContext(ctx, &rows, `
+		SELECT pull_request_id, user_id FROM pull_request_reviewers
+		WHERE pull_request_id = ANY($1::uuid[])
+	`, pq.Array(openIDs))
+	if err != nil {
+		log.Error(ctx, "Failed to get current reviewers", zap.Error(err))
+		return nil, err
+	}
+	current := make(map[[2]string]bool, len(rows))
+	for _, row := range rows {
+		current[[2]string{row.PullRequestID, row.UserID}] = true
+	}
+
+	var removePRs, removeUsers, toUsers, addPRs, addUsers []string
+	for _, r := range plan {
+		old := [2]string{r.PullRequestID, r.OldUserID}
+		if !open[r.PullRequestID] || !current[old] {
+			continue
+		}
+		if r.NewUserID != "" && current[[2]string{r.PullRequestID, r.NewUserID}] {
+			log.Warn(ctx, "Planned replacement already assigned",
+				zap.String("pull_request_id", r.PullRequestID),
+				zap.String("new_user_id", r.NewUserID),
+			)
+			r.NewUserID = ""
+		}
+		if r.NewUserID == "" && !release {
+			applied = append(applied, r)
+			continue
+		}
+
+		delete(current, old)
+		removePRs = append(removePRs, r.PullRequestID)
+		removeUsers = append(removeUsers, r.OldUserID)
+		toUsers = append(toUsers, r.NewUserID)
+		if r.NewUserID != "" {
+			current[[2]string{r.PullRequestID, r.NewUserID}] = true
+			addPRs = append(addPRs, r.PullRequestID)
+			addUsers = append(addUsers, r.NewUserID)
+		} else {
+			r.Released = true
+		}
+		applied = append(applied, r)
+	}
+
+	if err := checkReplacements(ctx, tx, log, addPRs, addUsers); err != nil {
+		return nil, err
+	}
+
+	if len(removePRs) > 0 {
+		res, err := tx.ExecContext(ctx, `
+			DELETE FROM pull_request_reviewers rev
+			USING unnest($1::uuid[], $2::uuid[]) AS x(pull_request_id, user_id)
+			WHERE rev.pull_request_id = x.pull_request_id AND rev.user_id = x.user_id
+		`, pq.Array(removePRs), pq.Array(removeUsers))
+		if err != nil {
+			log.Error(ctx, "Failed to release reviewers", zap.Error(err))
+			return nil, err
+		}
+		if n, _ := res.RowsAffected(); n != int64(len(removePRs)) {
+			log.Error(ctx, "Reviewers changed while reassigning", zap.Int("planned", len(removePRs)), zap.Int64("removed", n))
+			return nil, dto.ErrReviewersChanged
+		}
+	}
+
+	if len(addPRs) > 0 {
+		var assigned []struct {
+			PullRequestID string `db:"pull_request_id"`
+			UserID        string `db:"user_id"`
+		}
+		if err := tx.SelectContext(ctx, &assigned, `
+			INSERT INTO pull_request_reviewers (pull_request_id, user_id)
+			SELECT * FROM unnest($1::uuid[], $2::uuid[])
+			ON CONFLICT DO NOTHING
+			RETURNING pull_request_id, user_id
+		`, pq.Array(addPRs), pq.Array(addUsers)); err != nil {
+			log.Error(ctx, "Failed to assign new reviewers", zap.Error(err))
+			return nil, err
+		}
+		if len(assigned) != len(addPRs) {
+			log.Error(ctx, "Reviewers changed while reassigning", zap.Int("planned", len(addPRs)), zap.Int("assigned", len(assigned)))
+			return nil, dto.ErrReviewersChanged
+		}
+
+		assignedPRs := make([]string, 0, len(assigned))
+		assignedUsers := make([]string, 0, len(assigned))
+		for _, row := range assigned {
+			assignedPRs = append(assignedPRs, row.PullRequestID)
+			assignedUsers = append(assignedUsers, row.UserID)
+		}
+		if err := recordAssignments(ctx, tx, log, assignedPRs, assignedUsers); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := recordReassignments(ctx, tx, log, removePRs, removeUsers, toUsers); err != nil {
+		return nil, err
+	}
+
+	log.Info(ctx, "Reassignments applied", zap.Int("planned", len(plan)), zap.Int("applied", len(applied)))
+	return applied, nil
+}
+
+// checkReplacements перепроверяет в транзакции tx запланированные замены:
+// каждая должна по-прежнему иметь активное членство в команде PR, не
+// отсутствовать и не упираться в лимит открытых ревью с учётом назначений
+// этого же плана. Строки пользователей и членств блокируются до конца
+// транзакции.
+func checkReplacements(ctx context.Context, tx *sqlx.Tx, log logger.Logger, prIDs, userIDs []string) error {
+	if len(prIDs) == 0 {
+		return nil
+	}
+
+	var rows []struct {
+		PullRequestID  string `db:"pull_request_id"`
+		UserID         string `db:"user_id"`
+		IsActive       bool   `db:"is_active"`
+		Absent         bool   `db:"absent"`
+		MaxOpenReviews *int   `db:"max_open_reviews"`
+		OpenReviews    int    `db:"open_reviews"`
+	}
+	err := tx.SelectContext(ctx, &rows, `
+		SELECT x.pull_request_id, x.user_id,
+		       u.is_active AND m.is_active AS is_active,
+		       `+absentColumn+`,
+		       COALESCE(u.max_open_reviews, t.max_open_reviews) AS max_open_reviews,
+		       (SELECT COUNT(*) FROM pull_request_reviewers rev
+		        JOIN pull_requests p ON p.pull_request_id = rev.pull_request_id
+		        WHERE rev.user_id = u.user_id AND p.status = $3) AS open_reviews
+		FROM unnest($1::uuid[], $2::uuid[]) AS x(pull_request_id, user_id)
+		JOIN pull_requests pr ON pr.pull_request_id = x.pull_request_id
+		JOIN users u ON u.user_id = x.user_id
+		JOIN team_memberships m ON m.user_id = u.user_id AND m.team_name = pr.team_name
+		JOIN teams t ON t.team_name = m.team_name AND t.archived_at IS NULL
+		FOR SHARE OF u, m
+	`, pq.Array(prIDs), pq.Array(userIDs), entity.StatusOpen)
+	if err != nil {
+		log.Error(ctx, "Failed to check planned replacements", zap.Error(err))
+		return err
+	}
+	if len(rows) != len(prIDs) {
+		log.Warn(ctx, "Planned replacements left the PR team", zap.Int("planned", len(prIDs)), zap.Int("found", len(rows)))
+		return dto.ErrReviewersChanged
+	}
+
+	added := make(map[string]int, len(rows))
+	for _, row := range rows {
+		atLimit := row.MaxOpenReviews != nil && row.OpenReviews+added[row.UserID] >= *row.MaxOpenReviews
+		if !row.IsActive || row.Absent || atLimit {
+			log.Warn(ctx, "Planned replacement is no longer available",
+				zap.String("pull_request_id", row.PullRequestID),
+				zap.String("new_user_id", row.UserID),
+				zap.Bool("is_active", row.IsActive),
+				zap.Bool("absent", row.Absent),
+				zap.Bool("at_limit", atLimit),
+			)
+			return dto.ErrReviewersChanged
+		}
+		added[row.UserID]++
+	}
+
+	return nil
+}
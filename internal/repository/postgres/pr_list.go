@@ -0,0 +1,138 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"pr_reviewer_assignment_service/internal/entity"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+// List возвращает страницу PR по фильтру с текущими ревьюверами и их
+// вердиктами. Пагинация — keyset по (поле сортировки, pull_request_id),
+// поэтому каждая страница читается по индексу, а не через OFFSET.
+func (r *PRRepository) List(ctx context.Context, filter entity.PRListFilter) ([]*entity.PullRequest, error) {
+	r.logger.Debug(ctx, "List PRs called",
+		zap.String("sort_by", string(filter.SortBy)),
+		zap.Bool("desc", filter.Desc),
+		zap.Int("limit", filter.Limit),
+	)
+
+	sortCol := "pr.created_at"
+	if filter.SortBy == entity.SortByMergedAt {
+		sortCol = "pr.merged_at"
+	}
+	dir, cmp := "ASC", ">"
+	if filter.Desc {
+		dir, cmp = "DESC", "<"
+	}
+
+	query := r.sb.Select(
+		"pr.pull_request_id",
+		"pr.pull_request_name",
+		"COALESCE(pr.author_id::text, '') AS author_id",
+		"COALESCE(pr.team_name, au.team_name, '') AS team_name",
+		"pr.status",
+		"pr.under_staffed",
+		"pr.created_at",
+		"pr.merged_at",
+	).
+		From("pull_requests pr").
+		LeftJoin("users au ON au.user_id = pr.author_id").
+		OrderBy(sortCol+" "+dir, "pr.pull_request_id "+dir).
+		Limit(uint64(filter.Limit))
+
+	if len(filter.Statuses) > 0 {
+		query = query.Where(sq.Eq{"pr.status": filter.Statuses})
+	}
+	if filter.AuthorID != "" {
+		query = query.Where(sq.Eq{"pr.author_id": filter.AuthorID})
+	}
+	if filter.ReviewerID != "" {
+		query = query.Where(sq.Expr(`EXISTS (
+			SELECT 1 FROM pull_request_reviewers rev
+			WHERE rev.pull_request_id = pr.pull_request_id AND rev.user_id = ?
+		)`, filter.ReviewerID))
+	}
+	if filter.TeamName != "" {
+		query = query.Where(sq.Eq{"pr.team_name": filter.TeamName})
+	}
+	if filter.CreatedFrom != nil {
+		query = query.Where(sq.GtOrEq{"pr.created_at": *filter.CreatedFrom})
+	}
+	if filter.CreatedTo != nil {
+		query = query.Where(sq.Lt{"pr.created_at": *filter.CreatedTo})
+	}
+	if filter.MergedFrom != nil {
+		query = query.Where(sq.GtOrEq{"pr.merged_at": *filter.MergedFrom})
+	}
+	if filter.MergedTo != nil {
+		query = query.Where(sq.Lt{"pr.merged_at": *filter.MergedTo})
+	}
+	if filter.SortBy == entity.SortByMergedAt {
+		query = query.Where(sq.NotEq{"pr.merged_at": nil})
+	}
+	if filter.After != nil {
+		query = query.Where(
+			sq.Expr(fmt.Sprintf("(%s, pr.pull_request_id) %s (?, ?)", sortCol, cmp),
+				filter.After.At, filter.After.PullRequestID),
+		)
+	}
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		r.logger.Error(ctx, "Failed to build List PRs query", zap.Error(err))
+		return nil, err
+	}
+
+	var prs []entity.PullRequest
+	if err := r.db.SelectContext(ctx, &prs, sqlStr, args...); err != nil {
+		r.logger.Error(ctx, "Failed to list PRs", zap.Error(err))
+		return nil, err
+	}
+
+	result := make([]*entity.PullRequest, 0, len(prs))
+	if len(prs) == 0 {
+		return result, nil
+	}
+
+	prIDs := make([]string, 0, len(prs))
+	byID := make(map[string]*entity.PullRequest, len(prs))
+	for i := range prs {
+		prs[i].AssignedReviewers = []string{}
+		prIDs = append(prIDs, prs[i].PullRequestID)
+		byID[prs[i].PullRequestID] = &prs[i]
+		result = append(result, &prs[i])
+	}
+
+	var reviews []struct {
+		PullRequestID string `db:"pull_request_id"`
+		entity.Review
+	}
+	err = r.db.SelectContext(ctx, &reviews, `
+		SELECT rev.pull_request_id, rev.user_id, COALESCE(u.username, '') AS username, COALESCE(rev.verdict, '') AS verdict,
+		       rev.assigned_at, rev.verdict_at, rev.is_fallback
+		FROM pull_request_reviewers rev
+		LEFT JOIN users u ON u.user_id = rev.user_id
+		WHERE rev.pull_request_id = ANY($1::uuid[])
+		ORDER BY rev.assigned_at, rev.user_id
+	`, pq.Array(prIDs))
+	if err != nil {
+		r.logger.Error(ctx, "Failed to fetch reviewers for PRs", zap.Error(err))
+		return nil, err
+	}
+	for _, rev := range reviews {
+		pr := byID[rev.PullRequestID]
+		pr.Reviews = append(pr.Reviews, rev.Review)
+		pr.AssignedReviewers = append(pr.AssignedReviewers, rev.UserID)
+		if rev.Fallback {
+			pr.FallbackReviewers = append(pr.FallbackReviewers, rev.UserID)
+		}
+	}
+
+	r.logger.Debug(ctx, "List PRs completed", zap.Int("prs_count", len(result)))
+	return result, nil
+}
@@ -5,8 +5,9 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
-	"math/rand"
+	"slices"
 	"strings"
+	"time"
 
 	"pr_reviewer_assignment_service/internal/dto"
 	"pr_reviewer_assignment_service/internal/entity"
@@ -14,6 +15,7 @@ import (
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	"go.uber.org/zap"
 )
 
@@ -45,8 +47,8 @@ func (r *PRRepository) Create(ctx context.Context, pr *entity.PullRequest) error
 	}()
 
 	query := r.sb.Insert("pull_requests").
-		Columns("pull_request_id", "pull_request_name", "author_id", "status", "created_at").
-		Values(pr.PullRequestID, pr.Name, pr.AuthorID, pr.Status, pr.CreatedAt)
+		Columns("pull_request_id", "pull_request_name", "author_id", "team_name", "repository", "status", "under_staffed", "created_at").
+		Values(pr.PullRequestID, pr.Name, pr.AuthorID, sql.NullString{String: pr.TeamName, Valid: pr.TeamName != ""}, pr.Repository, pr.Status, pr.UnderStaffed, pr.CreatedAt)
 
 	sqlStr, args, err := query.ToSql()
 	if err != nil {
@@ -59,23 +61,17 @@ func (r *PRRepository) Create(ctx context.Context, pr *entity.PullRequest) error
 		return err
 	}
 
-	if len(pr.AssignedReviewers) > 0 {
-		valueStrings := []string{}
-		valueArgs := []interface{}{}
-		i := 1
-		for _, reviewer := range pr.AssignedReviewers {
-			valueStrings = append(valueStrings, fmt.Sprintf("($%d, $%d)", i, i+1))
-			valueArgs = append(valueArgs, pr.PullRequestID, reviewer)
-			i += 2
-		}
-
-		stmt := fmt.Sprintf(
-			"INSERT INTO pull_request_reviewers (pull_request_id, user_id) VALUES %s",
-			strings.Join(valueStrings, ","),
-		)
+	if err = r.insertReviewers(ctx, tx, pr.PullRequestID, pr.AssignedReviewers, pr.FallbackReviewers); err != nil {
+		return err
+	}
 
-		if _, err = tx.ExecContext(ctx, stmt, valueArgs...); err != nil {
-			r.logger.Error(ctx, "Failed to insert reviewers", zap.Error(err))
+	if len(pr.ChangedFiles) > 0 {
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO pull_request_files (pull_request_id, path)
+			SELECT $1, unnest($2::text[])
+		`, pr.PullRequestID, pq.Array(pr.ChangedFiles))
+		if err != nil {
+			r.logger.Error(ctx, "Failed to insert changed files", zap.Error(err))
 			return err
 		}
 	}
@@ -89,12 +85,44 @@ func (r *PRRepository) Create(ctx context.Context, pr *entity.PullRequest) error
 	return nil
 }
 
+func (r *PRRepository) insertReviewers(ctx context.Context, tx *sqlx.Tx, prID string, reviewers, fallback []string) error {
+	if len(reviewers) == 0 {
+		return nil
+	}
+
+	valueStrings := []string{}
+	valueArgs := []interface{}{}
+	i := 1
+	for _, reviewer := range reviewers {
+		valueStrings = append(valueStrings, fmt.Sprintf("($%d, $%d, $%d)", i, i+1, i+2))
+		valueArgs = append(valueArgs, prID, reviewer, slices.Contains(fallback, reviewer))
+		i += 3
+	}
+
+	stmt := fmt.Sprintf(
+		"INSERT INTO pull_request_reviewers (pull_request_id, user_id, is_fallback) VALUES %s",
+		strings.Join(valueStrings, ","),
+	)
+
+	if _, err := tx.ExecContext(ctx, stmt, valueArgs...); err != nil {
+		r.logger.Error(ctx, "Failed to insert reviewers", zap.Error(err))
+		return err
+	}
+
+	prIDs := make([]string, len(reviewers))
+	for i := range prIDs {
+		prIDs[i] = prID
+	}
+	return recordAssignments(ctx, tx, r.logger, prIDs, reviewers)
+}
+
 func (r *PRRepository) GetByID(ctx context.Context, prID string) (*entity.PullRequest, error) {
 	r.logger.Debug(ctx, "GetByID called", zap.String("pr_id", prID))
 
 	var pr entity.PullRequest
 	err := r.db.GetContext(ctx, &pr, `
-		SELECT pull_request_id, pull_request_name, author_id, status, created_at, merged_at
+		SELECT pull_request_id, pull_request_name, COALESCE(author_id::text, '') AS author_id, COALESCE(team_name, '') AS team_name,
+		       repository, status, under_staffed, created_at, merged_at
 		FROM pull_requests
 		WHERE pull_request_id=$1
 	`, prID)
@@ -107,21 +135,89 @@ func (r *PRRepository) GetByID(ctx context.Context, prID string) (*entity.PullRe
 		return nil, err
 	}
 
-	var reviewers []string
-	err = r.db.SelectContext(ctx, &reviewers, "SELECT user_id FROM pull_request_reviewers WHERE pull_request_id=$1", prID)
+	reviews, err := r.getReviews(ctx, r.db, prID)
 	if err != nil {
-		r.logger.Error(ctx, "Failed to get reviewers", zap.String("pr_id", prID), zap.Error(err))
 		return nil, err
 	}
-	pr.AssignedReviewers = reviewers
 
-	r.logger.Debug(ctx, "GetByID successful", zap.String("pr_id", prID), zap.Int("reviewers_count", len(reviewers)))
+	err = r.db.SelectContext(ctx, &pr.ChangedFiles, `
+		SELECT path FROM pull_request_files WHERE pull_request_id=$1 ORDER BY path
+	`, prID)
+	if err != nil {
+		r.logger.Error(ctx, "Failed to get changed files", zap.String("pr_id", prID), zap.Error(err))
+		return nil, err
+	}
+
+	pr.Reviews = reviews
+	pr.AssignedReviewers = make([]string, 0, len(reviews))
+	for _, rev := range reviews {
+		pr.AssignedReviewers = append(pr.AssignedReviewers, rev.UserID)
+		if rev.Fallback {
+			pr.FallbackReviewers = append(pr.FallbackReviewers, rev.UserID)
+		}
+	}
+
+	r.logger.Debug(ctx, "GetByID successful", zap.String("pr_id", prID), zap.Int("reviewers_count", len(reviews)))
 	return &pr, nil
 }
 
-func (r *PRRepository) Merge(ctx context.Context, prID string, prEntity *entity.PullRequest) error {
+// getReviews возвращает ревьюверов PR с вердиктами в порядке назначения.
+func (r *PRRepository) getReviews(ctx context.Context, q sqlx.QueryerContext, prID string) ([]entity.Review, error) {
+	var reviews []entity.Review
+	err := sqlx.SelectContext(ctx, q, &reviews, `
+		SELECT rev.user_id, COALESCE(u.username, '') AS username, COALESCE(rev.verdict, '') AS verdict,
+		       rev.assigned_at, rev.verdict_at, rev.is_fallback
+		FROM pull_request_reviewers rev
+		LEFT JOIN users u ON u.user_id = rev.user_id
+		WHERE rev.pull_request_id=$1
+		ORDER BY rev.assigned_at, rev.user_id
+	`, prID)
+	if err != nil {
+		r.logger.Error(ctx, "Failed to get reviewers", zap.String("pr_id", prID), zap.Error(err))
+		return nil, err
+	}
+	return reviews, nil
+}
+
+// Merge переводит открытый PR в MERGED. check вызывается под блокировкой PR с
+// текущими вердиктами ревьюверов; его ошибка отменяет merge.
+func (r *PRRepository) Merge(ctx context.Context, prID string, prEntity *entity.PullRequest, check func([]entity.Review) error) error {
 	r.logger.Info(ctx, "Merging Pull Request", zap.String("pr_id", prID))
 
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		r.logger.Error(ctx, "Failed to begin transaction", zap.Error(err))
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	status, err := r.lockStatus(ctx, tx, prID)
+	if err != nil {
+		return err
+	}
+	if status == entity.StatusMerged {
+		r.logger.Warn(ctx, "PR already merged", zap.String("pr_id", prID))
+		err = dto.ErrPRMerged
+		return err
+	}
+	if status != entity.StatusOpen {
+		r.logger.Warn(ctx, "PR is not open", zap.String("pr_id", prID), zap.String("status", string(status)))
+		err = dto.ErrInvalidTransition
+		return err
+	}
+
+	var reviews []entity.Review
+	if reviews, err = r.getReviews(ctx, tx, prID); err != nil {
+		return err
+	}
+	if err = check(reviews); err != nil {
+		return err
+	}
+
 	query := r.sb.Update("pull_requests").
 		Set("status", prEntity.Status).
 		Set("merged_at", prEntity.MergedAt).
@@ -133,22 +229,147 @@ func (r *PRRepository) Merge(ctx context.Context, prID string, prEntity *entity.
 		return err
 	}
 
-	res, err := r.db.ExecContext(ctx, sqlStr, args...)
-	if err != nil {
+	if _, err = tx.ExecContext(ctx, sqlStr, args...); err != nil {
 		r.logger.Error(ctx, "Failed to execute Merge", zap.Error(err), zap.String("pr_id", prID))
 		return err
 	}
 
-	rows, _ := res.RowsAffected()
-	if rows == 0 {
-		r.logger.Warn(ctx, "No PR found to merge", zap.String("pr_id", prID))
-		return dto.ErrNotFound
+	if err = tx.Commit(); err != nil {
+		r.logger.Error(ctx, "Failed to commit transaction for merge", zap.Error(err))
+		return err
 	}
 
 	r.logger.Info(ctx, "Pull Request merged successfully", zap.String("pr_id", prID))
 	return nil
 }
 
+func (r *PRRepository) UpdateStatus(ctx context.Context, prEntity *entity.PullRequest, from entity.PRStatus) error {
+	prID := prEntity.PullRequestID
+	r.logger.Info(ctx, "Updating Pull Request status",
+		zap.String("pr_id", prID),
+		zap.String("from", string(from)),
+		zap.String("to", string(prEntity.Status)),
+	)
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		r.logger.Error(ctx, "Failed to begin transaction", zap.Error(err))
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	status, err := r.lockStatus(ctx, tx, prID)
+	if err != nil {
+		return err
+	}
+	if status != from {
+		r.logger.Warn(ctx, "PR status changed concurrently", zap.String("pr_id", prID), zap.String("status", string(status)))
+		err = dto.ErrInvalidTransition
+		return err
+	}
+
+	if _, err = tx.ExecContext(ctx,
+		"UPDATE pull_requests SET status=$1, under_staffed=$2 WHERE pull_request_id=$3",
+		prEntity.Status, prEntity.UnderStaffed, prID,
+	); err != nil {
+		r.logger.Error(ctx, "Failed to update PR status", zap.Error(err))
+		return err
+	}
+
+	if _, err = tx.ExecContext(ctx, "DELETE FROM pull_request_reviewers WHERE pull_request_id=$1", prID); err != nil {
+		r.logger.Error(ctx, "Failed to release reviewers", zap.Error(err))
+		return err
+	}
+
+	if err = r.insertReviewers(ctx, tx, prID, prEntity.AssignedReviewers, prEntity.FallbackReviewers); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.logger.Error(ctx, "Failed to commit transaction for status update", zap.Error(err))
+		return err
+	}
+
+	r.logger.Info(ctx, "Pull Request status updated", zap.String("pr_id", prID), zap.String("status", string(prEntity.Status)))
+	return nil
+}
+
+func (r *PRRepository) SubmitVerdict(ctx context.Context, prID, userID string, verdict entity.ReviewVerdict, at time.Time) error {
+	r.logger.Info(ctx, "Submitting review verdict", zap.String("pr_id", prID), zap.String("user_id", userID), zap.String("verdict", string(verdict)))
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		r.logger.Error(ctx, "Failed to begin transaction", zap.Error(err))
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	status, err := r.lockStatus(ctx, tx, prID)
+	if err != nil {
+		return err
+	}
+	if status == entity.StatusMerged {
+		r.logger.Warn(ctx, "Cannot review merged PR", zap.String("pr_id", prID))
+		err = dto.ErrPRMerged
+		return err
+	}
+	if status != entity.StatusOpen {
+		r.logger.Warn(ctx, "Cannot review PR that is not open", zap.String("pr_id", prID), zap.String("status", string(status)))
+		err = dto.ErrPRNotOpen
+		return err
+	}
+
+	res, err := tx.ExecContext(ctx,
+		"UPDATE pull_request_reviewers SET verdict=$1, verdict_at=$2 WHERE pull_request_id=$3 AND user_id=$4",
+		verdict, at, prID, userID,
+	)
+	if err != nil {
+		r.logger.Error(ctx, "Failed to store verdict", zap.Error(err))
+		return err
+	}
+	if rows, _ := res.RowsAffected(); rows == 0 {
+		r.logger.Warn(ctx, "Reviewer not assigned to PR", zap.String("pr_id", prID), zap.String("user_id", userID))
+		err = dto.ErrNotAssigned
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.logger.Error(ctx, "Failed to commit transaction for verdict", zap.Error(err))
+		return err
+	}
+
+	r.logger.Info(ctx, "Review verdict stored", zap.String("pr_id", prID), zap.String("user_id", userID))
+	return nil
+}
+
+// lockStatus блокирует строку PR до конца транзакции и возвращает его статус,
+// чтобы merge и изменения ревьюверов не могли выполняться одновременно.
+func (r *PRRepository) lockStatus(ctx context.Context, tx *sqlx.Tx, prID string) (entity.PRStatus, error) {
+	var status entity.PRStatus
+	err := tx.GetContext(ctx, &status,
+		"SELECT status FROM pull_requests WHERE pull_request_id=$1 FOR UPDATE",
+		prID,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			r.logger.Warn(ctx, "PR not found", zap.String("pr_id", prID))
+			return "", dto.ErrNotFound
+		}
+		r.logger.Error(ctx, "Failed to lock PR", zap.String("pr_id", prID), zap.Error(err))
+		return "", err
+	}
+
+	return status, nil
+}
+
 func (r *PRRepository) ReassignReviewer(ctx context.Context, prID, oldUserID, newUserID string) (*entity.PullRequest, error) {
 	r.logger.Info(ctx, "Reassigning reviewer", zap.String("pr_id", prID), zap.String("old_user_id", oldUserID), zap.String("new_user_id", newUserID))
 
@@ -163,6 +384,21 @@ func (r *PRRepository) ReassignReviewer(ctx context.Context, prID, oldUserID, ne
 		}
 	}()
 
+	status, err := r.lockStatus(ctx, tx, prID)
+	if err != nil {
+		return nil, err
+	}
+	if status == entity.StatusMerged {
+		r.logger.Warn(ctx, "Cannot reassign reviewer on merged PR", zap.String("pr_id", prID))
+		err = dto.ErrPRMerged
+		return nil, err
+	}
+	if status != entity.StatusOpen {
+		r.logger.Warn(ctx, "Cannot reassign reviewer on PR that is not open", zap.String("pr_id", prID), zap.String("status", string(status)))
+		err = dto.ErrPRNotOpen
+		return nil, err
+	}
+
 	var exists bool
 	err = tx.GetContext(ctx, &exists,
 		"SELECT EXISTS(SELECT 1 FROM pull_request_reviewers WHERE pull_request_id=$1 AND user_id=$2)",
@@ -174,7 +410,8 @@ func (r *PRRepository) ReassignReviewer(ctx context.Context, prID, oldUserID, ne
 	}
 	if !exists {
 		r.logger.Warn(ctx, "Old reviewer not assigned to PR", zap.String("pr_id", prID), zap.String("user_id", oldUserID))
-		return nil, dto.ErrNotAssigned
+		err = dto.ErrNotAssigned
+		return nil, err
 	}
 
 	_, err = tx.ExecContext(ctx,
@@ -186,69 +423,37 @@ func (r *PRRepository) ReassignReviewer(ctx context.Context, prID, oldUserID, ne
 		return nil, err
 	}
 
-	if newUserID == "" {
-		var candidates []string
-		query := `
-			SELECT user_id 
-			FROM users 
-			WHERE team_name = (
-				SELECT team_name 
-				FROM users 
-				WHERE user_id = $1
-			) 
-			AND is_active = TRUE
-			AND user_id != $1
-		`
-		err := tx.SelectContext(ctx, &candidates, query, oldUserID)
-		if err != nil {
-			r.logger.Error(ctx, "Failed to fetch candidate reviewers", zap.Error(err))
-			return nil, err
-		}
-
-		if len(candidates) == 0 {
-			r.logger.Warn(ctx, "No candidate reviewers available", zap.String("pr_id", prID))
-			return nil, dto.ErrNoCandidate
-		}
-
-		newUserID = candidates[rand.Intn(len(candidates))]
-		r.logger.Info(ctx, "Auto-selected new reviewer", zap.String("new_user_id", newUserID))
-	}
-
-	_, err = tx.ExecContext(ctx,
-		"INSERT INTO pull_request_reviewers (pull_request_id, user_id) VALUES ($1, $2)",
+	res, err := tx.ExecContext(ctx,
+		"INSERT INTO pull_request_reviewers (pull_request_id, user_id) VALUES ($1, $2) ON CONFLICT DO NOTHING",
 		prID, newUserID,
 	)
 	if err != nil {
 		r.logger.Error(ctx, "Failed to insert new reviewer", zap.Error(err))
 		return nil, err
 	}
+	if rows, _ := res.RowsAffected(); rows == 0 {
+		r.logger.Warn(ctx, "New reviewer already assigned to PR", zap.String("pr_id", prID), zap.String("user_id", newUserID))
+		err = dto.ErrAlreadyAssigned
+		return nil, err
+	}
 
-	if err = tx.Commit(); err != nil {
-		r.logger.Error(ctx, "Failed to commit transaction for reassignment", zap.Error(err))
+	if err = recordAssignments(ctx, tx, r.logger, []string{prID}, []string{newUserID}); err != nil {
+		return nil, err
+	}
+	if err = recordReassignments(ctx, tx, r.logger, []string{prID}, []string{oldUserID}, []string{newUserID}); err != nil {
 		return nil, err
 	}
 
-	prEntity := &entity.PullRequest{}
-	err = r.db.GetContext(ctx, prEntity,
-		`SELECT pull_request_id, pull_request_name, author_id, status
-		 FROM pull_requests WHERE pull_request_id=$1`,
-		prID,
-	)
-	if err != nil {
-		r.logger.Error(ctx, "Failed to fetch PR after reassignment", zap.Error(err))
+	if err = tx.Commit(); err != nil {
+		r.logger.Error(ctx, "Failed to commit transaction for reassignment", zap.Error(err))
 		return nil, err
 	}
 
-	var reviewers []string
-	err = r.db.SelectContext(ctx, &reviewers,
-		"SELECT user_id FROM pull_request_reviewers WHERE pull_request_id=$1",
-		prID,
-	)
+	prEntity, err := r.GetByID(ctx, prID)
 	if err != nil {
-		r.logger.Error(ctx, "Failed to fetch reviewers after reassignment", zap.Error(err))
+		r.logger.Error(ctx, "Failed to fetch PR after reassignment", zap.Error(err))
 		return nil, err
 	}
-	prEntity.AssignedReviewers = reviewers
 
 	r.logger.Info(ctx, "Reviewer reassigned successfully", zap.String("pr_id", prID), zap.String("new_user_id", newUserID))
 	return prEntity, nil
@@ -260,8 +465,10 @@ func (r *PRRepository) GetByReviewer(ctx context.Context, userID string) ([]*ent
 	query := `
 		SELECT pr.pull_request_id,
 		       pr.pull_request_name,
-		       pr.author_id,
+		       COALESCE(pr.author_id::text, '') AS author_id,
+		       COALESCE(pr.team_name, '') AS team_name,
 		       pr.status,
+		       pr.under_staffed,
 		       pr.created_at,
 		       pr.merged_at
 		FROM pull_requests pr
@@ -294,3 +501,109 @@ func (r *PRRepository) GetByReviewer(ctx context.Context, userID string) ([]*ent
 	r.logger.Debug(ctx, "GetByReviewer completed", zap.String("user_id", userID), zap.Int("prs_count", len(result)))
 	return result, nil
 }
+
+func (r *PRRepository) CountOpenReviews(ctx context.Context, userIDs []string) (map[string]int, error) {
+	r.logger.Debug(ctx, "CountOpenReviews called", zap.Int("users_count", len(userIDs)))
+
+	loads := make(map[string]int, len(userIDs))
+	if len(userIDs) == 0 {
+		return loads, nil
+	}
+
+	query := r.sb.Select("rev.user_id", "COUNT(*) AS open_reviews").
+		From("pull_request_reviewers rev").
+		Join("pull_requests pr ON pr.pull_request_id = rev.pull_request_id").
+		Where(sq.Eq{"pr.status": entity.StatusOpen, "rev.user_id": userIDs}).
+		GroupBy("rev.user_id")
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		r.logger.Error(ctx, "Failed to build CountOpenReviews query", zap.Error(err))
+		return nil, err
+	}
+
+	var rows []struct {
+		UserID      string `db:"user_id"`
+		OpenReviews int    `db:"open_reviews"`
+	}
+	if err := r.db.SelectContext(ctx, &rows, sqlStr, args...); err != nil {
+		r.logger.Error(ctx, "Failed to count open reviews", zap.Error(err))
+		return nil, err
+	}
+
+	for _, row := range rows {
+		loads[row.UserID] = row.OpenReviews
+	}
+
+	r.logger.Debug(ctx, "CountOpenReviews completed", zap.Int("users_with_reviews", len(rows)))
+	return loads, nil
+}
+
+// GetOpenByReviewers возвращает открытые PR, где ревьювером назначен кто-то
+// из userIDs, со всеми их ревьюверами. Для PR без команды TeamName — основная
+// команда автора.
+func (r *PRRepository) GetOpenByReviewers(ctx context.Context, userIDs []string) ([]*entity.PullRequest, error) {
+	r.logger.Debug(ctx, "GetOpenByReviewers called", zap.Int("users_count", len(userIDs)))
+
+	result := []*entity.PullRequest{}
+	if len(userIDs) == 0 {
+		return result, nil
+	}
+
+	var prs []entity.PullRequest
+	err := r.db.SelectContext(ctx, &prs, `
+		SELECT pr.pull_request_id,
+		       pr.pull_request_name,
+		       COALESCE(pr.author_id::text, '') AS author_id,
+		       COALESCE(pr.team_name, au.team_name, '') AS team_name,
+		       pr.status,
+		       pr.under_staffed,
+		       pr.created_at,
+		       pr.merged_at
+		FROM pull_requests pr
+		LEFT JOIN users au ON au.user_id = pr.author_id
+		WHERE pr.status = $1
+		  AND EXISTS (
+		      SELECT 1 FROM pull_request_reviewers rev
+		      WHERE rev.pull_request_id = pr.pull_request_id AND rev.user_id = ANY($2::uuid[])
+		  )
+		ORDER BY pr.created_at, pr.pull_request_id
+	`, entity.StatusOpen, pq.Array(userIDs))
+	if err != nil {
+		r.logger.Error(ctx, "Failed to fetch open PRs by reviewers", zap.Error(err))
+		return nil, err
+	}
+	if len(prs) == 0 {
+		return result, nil
+	}
+
+	prIDs := make([]string, 0, len(prs))
+	byID := make(map[string]*entity.PullRequest, len(prs))
+	for i := range prs {
+		prIDs = append(prIDs, prs[i].PullRequestID)
+		byID[prs[i].PullRequestID] = &prs[i]
+		result = append(result, &prs[i])
+	}
+
+	var reviewers []struct {
+		PullRequestID string `db:"pull_request_id"`
+		UserID        string `db:"user_id"`
+	}
+	err = r.db.SelectContext(ctx, &reviewers, `
+		SELECT pull_request_id, user_id
+		FROM pull_request_reviewers
+		WHERE pull_request_id = ANY($1::uuid[])
+		ORDER BY assigned_at, user_id
+	`, pq.Array(prIDs))
+	if err != nil {
+		r.logger.Error(ctx, "Failed to fetch reviewers for PRs", zap.Error(err))
+		return nil, err
+	}
+	for _, rev := range reviewers {
+		pr := byID[rev.PullRequestID]
+		pr.AssignedReviewers = append(pr.AssignedReviewers, rev.UserID)
+	}
+
+	r.logger.Debug(ctx, "GetOpenByReviewers completed", zap.Int("prs_count", len(result)))
+	return result, nil
+}
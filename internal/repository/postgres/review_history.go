@@ -0,0 +1,46 @@
+package postgres
+
+import (
+	"context"
+
+	"pr_reviewer_assignment_service/pkg/logger"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+// recordAssignments дописывает назначения ревьюверов в историю
+// review_assignments. prIDs и userIDs — параллельные массивы.
+func recordAssignments(ctx context.Context, tx *sqlx.Tx, log logger.Logger, prIDs, userIDs []string) error {
+	if len(prIDs) == 0 {
+		return nil
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO review_assignments (pull_request_id, user_id)
+		SELECT * FROM unnest($1::uuid[], $2::uuid[])
+	`, pq.Array(prIDs), pq.Array(userIDs)); err != nil {
+		log.Error(ctx, "Failed to record review assignments", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// recordReassignments дописывает в историю review_reassignments снятие
+// ревьюверов fromUsers с PR. Пустой toUsers[i] означает снятие без замены.
+func recordReassignments(ctx context.Context, tx *sqlx.Tx, log logger.Logger, prIDs, fromUsers, toUsers []string) error {
+	if len(prIDs) == 0 {
+		return nil
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO review_reassignments (pull_request_id, from_user_id, to_user_id)
+		SELECT pr_id, from_user, NULLIF(to_user, '')::uuid
+		FROM unnest($1::uuid[], $2::uuid[], $3::text[]) AS x(pr_id, from_user, to_user)
+	`, pq.Array(prIDs), pq.Array(fromUsers), pq.Array(toUsers)); err != nil {
+		log.Error(ctx, "Failed to record review reassignments", zap.Error(err))
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,316 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"pr_reviewer_assignment_service/internal/entity"
+	"pr_reviewer_assignment_service/pkg/logger"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+// reviewCountersCTE считает счётчики ревью по пользователям одним проходом
+// по каждой таблице. Параметры: $1 и $2 — границы интервала (NULL — без
+// ограничения), $3 — команда (NULL — все).
+const reviewCountersCTE = `
+WITH assigned AS (
+	SELECT user_id, COUNT(*) AS n
+	FROM review_assignments
+	WHERE ($1::timestamptz IS NULL OR assigned_at >= $1)
+	  AND ($2::timestamptz IS NULL OR assigned_at < $2)
+	GROUP BY user_id
+), open_reviews AS (
+	SELECT rev.user_id, COUNT(*) AS n
+	FROM pull_request_reviewers rev
+	JOIN pull_requests pr ON pr.pull_request_id = rev.pull_request_id
+	WHERE pr.status = 'OPEN'
+	GROUP BY rev.user_id
+), reassigned AS (
+	SELECT from_user_id AS user_id, COUNT(*) AS n
+	FROM review_reassignments
+	WHERE ($1::timestamptz IS NULL OR reassigned_at >= $1)
+	  AND ($2::timestamptz IS NULL OR reassigned_at < $2)
+	GROUP BY from_user_id
+), merged AS (
+	SELECT rev.user_id, COUNT(*) AS n
+	FROM pull_request_reviewers rev
+	JOIN pull_requests pr ON pr.pull_request_id = rev.pull_request_id
+	WHERE pr.status = 'MERGED'
+	  AND ($1::timestamptz IS NULL OR pr.merged_at >= $1)
+	  AND ($2::timestamptz IS NULL OR pr.merged_at < $2)
+	GROUP BY rev.user_id
+), counters AS (
+	SELECT u.user_id,
+		COALESCE(a.n, 0) AS assignments,
+		COALESCE(o.n, 0) AS open_assignments,
+		COALESCE(r.n, 0) AS reassigned_away,
+		COALESCE(m.n, 0) AS merged_reviewed
+	FROM users u
+	LEFT JOIN assigned a ON a.user_id = u.user_id
+	LEFT JOIN open_reviews o ON o.user_id = u.user_id
+	LEFT JOIN reassigned r ON r.user_id = u.user_id
+	LEFT JOIN merged m ON m.user_id = u.user_id
+)
+`
+
+type StatsRepository struct {
+	db     *sqlx.DB
+	logger logger.Logger
+}
+
+func NewStatsRepository(db *sqlx.DB, logger logger.Logger) *StatsRepository {
+	return &StatsRepository{db: db, logger: logger}
+}
+
+func statsArgs(filter entity.StatsFilter) []interface{} {
+	return []interface{}{
+		filter.From,
+		filter.To,
+		sql.NullString{String: filter.TeamName, Valid: filter.TeamName != ""},
+	}
+}
+
+// TeamExists проверяет, есть ли команда teamName, в том числе архивная.
+func (r *StatsRepository) TeamExists(ctx context.Context, teamName string) (bool, error) {
+	var exists bool
+	err := r.db.GetContext(ctx, &exists, `SELECT EXISTS (SELECT 1 FROM teams WHERE team_name = $1)`, teamName)
+	if err != nil {
+		r.logger.Error(ctx, "Failed to check team existence", zap.String("team_name", teamName), zap.Error(err))
+		return false, err
+	}
+
+	return exists, nil
+}
+
+// GetReviewerStats возвращает счётчики ревью по пользователям. При фильтре
+// по команде — только по её участникам.
+func (r *StatsRepository) GetReviewerStats(ctx context.Context, filter entity.StatsFilter) ([]entity.ReviewerStats, error) {
+	r.logger.Debug(ctx, "GetReviewerStats called", zap.String("team_name", filter.TeamName))
+
+	result := []entity.ReviewerStats{}
+	err := r.db.SelectContext(ctx, &result, reviewCountersCTE+`
+		SELECT u.user_id, u.username, u.team_name,
+			c.assignments, c.open_assignments, c.reassigned_away, c.merged_reviewed
+		FROM users u
+		JOIN counters c ON c.user_id = u.user_id
+		WHERE $3::text IS NULL OR EXISTS (
+			SELECT 1 FROM team_memberships tm WHERE tm.user_id = u.user_id AND tm.team_name = $3
+		)
+		ORDER BY u.team_name, u.username, u.user_id
+	`, statsArgs(filter)...)
+	if err != nil {
+		r.logger.Error(ctx, "Failed to get reviewer stats", zap.Error(err))
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetTeamReviewStats возвращает суммы счётчиков по участникам команд.
+// Архивные команды учитываются только при явном фильтре.
+func (r *StatsRepository) GetTeamReviewStats(ctx context.Context, filter entity.StatsFilter) ([]entity.TeamReviewStats, error) {
+	r.logger.Debug(ctx, "GetTeamReviewStats called", zap.String("team_name", filter.TeamName))
+
+	result := []entity.TeamReviewStats{}
+	err := r.db.SelectContext(ctx, &result, reviewCountersCTE+`
+		SELECT t.team_name,
+			COUNT(tm.user_id) AS members,
+			COALESCE(SUM(c.assignments), 0) AS assignments,
+			COALESCE(SUM(c.open_assignments), 0) AS open_assignments,
+			COALESCE(SUM(c.reassigned_away), 0) AS reassigned_away,
+			COALESCE(SUM(c.merged_reviewed), 0) AS merged_reviewed
+		FROM teams t
+		LEFT JOIN team_memberships tm ON tm.team_name = t.team_name
+		LEFT JOIN counters c ON c.user_id = tm.user_id
+		WHERE ($3::text IS NULL AND t.archived_at IS NULL) OR t.team_name = $3
+		GROUP BY t.team_name
+		ORDER BY t.team_name
+	`, statsArgs(filter)...)
+	if err != nil {
+		r.logger.Error(ctx, "Failed to get team review stats", zap.Error(err))
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// latencyPercentiles агрегирует замеры samples(metric, seconds) в
+// перцентили.
+const latencyPercentiles = `
+	metric,
+	COUNT(*) AS samples,
+	percentile_cont(0.5) WITHIN GROUP (ORDER BY seconds) AS p50,
+	percentile_cont(0.9) WITHIN GROUP (ORDER BY seconds) AS p90,
+	percentile_cont(0.99) WITHIN GROUP (ORDER BY seconds) AS p99
+`
+
+// GetTeamLatency считает перцентили времени до первого ревью и до merge по
+// командам PR. Параметры те же, что у reviewCountersCTE.
+func (r *StatsRepository) GetTeamLatency(ctx context.Context, filter entity.StatsFilter) ([]entity.LatencyStats, error) {
+	r.logger.Debug(ctx, "GetTeamLatency called", zap.String("team_name", filter.TeamName))
+
+	result := []entity.LatencyStats{}
+	err := r.db.SelectContext(ctx, &result, `
+		WITH prs AS (
+			SELECT pr.pull_request_id, COALESCE(pr.team_name, au.team_name) AS team_name,
+				pr.status, pr.created_at, pr.merged_at
+			FROM pull_requests pr
+			JOIN users au ON au.user_id = pr.author_id
+			WHERE $3::text IS NULL OR COALESCE(pr.team_name, au.team_name) = $3
+		), first_reviews AS (
+			SELECT p.team_name, MIN(rev.assigned_at) AS started_at, MIN(rev.verdict_at) AS finished_at
+			FROM prs p
+			JOIN pull_request_reviewers rev ON rev.pull_request_id = p.pull_request_id
+			GROUP BY p.pull_request_id, p.team_name
+		), samples AS (
+			SELECT team_name, '`+entity.LatencyFirstReview+`' AS metric,
+				EXTRACT(EPOCH FROM finished_at - started_at) AS seconds
+			FROM first_reviews
+			WHERE finished_at IS NOT NULL
+			  AND ($1::timestamptz IS NULL OR finished_at >= $1)
+			  AND ($2::timestamptz IS NULL OR finished_at < $2)
+			UNION ALL
+			SELECT team_name, '`+entity.LatencyMerge+`',
+				EXTRACT(EPOCH FROM merged_at - created_at)
+			FROM prs
+			WHERE status = 'MERGED' AND created_at IS NOT NULL AND merged_at IS NOT NULL
+			  AND ($1::timestamptz IS NULL OR merged_at >= $1)
+			  AND ($2::timestamptz IS NULL OR merged_at < $2)
+		)
+		SELECT team_name, `+latencyPercentiles+`
+		FROM samples
+		GROUP BY team_name, metric
+		ORDER BY team_name, metric
+	`, statsArgs(filter)...)
+	if err != nil {
+		r.logger.Error(ctx, "Failed to get team latency", zap.Error(err))
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetReviewerLatency считает перцентили времени от назначения ревьювера до
+// его вердикта и до merge PR. При фильтре по команде учитываются только PR
+// этой команды.
+func (r *StatsRepository) GetReviewerLatency(ctx context.Context, filter entity.StatsFilter) ([]entity.LatencyStats, error) {
+	r.logger.Debug(ctx, "GetReviewerLatency called", zap.String("team_name", filter.TeamName))
+
+	result := []entity.LatencyStats{}
+	err := r.db.SelectContext(ctx, &result, `
+		WITH reviews AS (
+			SELECT rev.user_id, rev.assigned_at, rev.verdict_at, pr.status, pr.merged_at
+			FROM pull_request_reviewers rev
+			JOIN pull_requests pr ON pr.pull_request_id = rev.pull_request_id
+			JOIN users au ON au.user_id = pr.author_id
+			WHERE $3::text IS NULL OR COALESCE(pr.team_name, au.team_name) = $3
+		), samples AS (
+			SELECT user_id, '`+entity.LatencyFirstReview+`' AS metric,
+				EXTRACT(EPOCH FROM verdict_at - assigned_at) AS seconds
+			FROM reviews
+			WHERE verdict_at IS NOT NULL
+			  AND ($1::timestamptz IS NULL OR verdict_at >= $1)
+			  AND ($2::timestamptz IS NULL OR verdict_at < $2)
+			UNION ALL
+			SELECT user_id, '`+entity.LatencyMerge+`',
+				EXTRACT(EPOCH FROM merged_at - assigned_at)
+			FROM reviews
+			WHERE status = 'MERGED' AND merged_at IS NOT NULL
+			  AND ($1::timestamptz IS NULL OR merged_at >= $1)
+			  AND ($2::timestamptz IS NULL OR merged_at < $2)
+		)
+		SELECT s.user_id, u.username, `+latencyPercentiles+`
+		FROM samples s
+		JOIN users u ON u.user_id = s.user_id
+		GROUP BY s.user_id, u.username, metric
+		ORDER BY u.username, s.user_id, metric
+	`, statsArgs(filter)...)
+	if err != nil {
+		r.logger.Error(ctx, "Failed to get reviewer latency", zap.Error(err))
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetMemberLoads возвращает число открытых ревью каждого активного участника
+// команды teamName или, если она не задана, всех неархивных команд.
+func (r *StatsRepository) GetMemberLoads(ctx context.Context, teamName string) ([]entity.MemberLoad, error) {
+	r.logger.Debug(ctx, "GetMemberLoads called", zap.String("team_name", teamName))
+
+	result := []entity.MemberLoad{}
+	err := r.db.SelectContext(ctx, &result, `
+		SELECT tm.team_name, tm.user_id, COUNT(pr.pull_request_id) AS open_reviews
+		FROM team_memberships tm
+		JOIN teams t ON t.team_name = tm.team_name
+		JOIN users u ON u.user_id = tm.user_id
+		LEFT JOIN pull_request_reviewers rev ON rev.user_id = tm.user_id
+		LEFT JOIN pull_requests pr ON pr.pull_request_id = rev.pull_request_id AND pr.status = 'OPEN'
+		WHERE tm.is_active AND u.is_active
+		  AND (($1::text IS NULL AND t.archived_at IS NULL) OR t.team_name = $1)
+		GROUP BY tm.team_name, tm.user_id
+		ORDER BY tm.team_name, tm.user_id
+	`, sql.NullString{String: teamName, Valid: teamName != ""})
+	if err != nil {
+		r.logger.Error(ctx, "Failed to get member loads", zap.Error(err))
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetImbalanceStates возвращает состояние каждой команды по последнему
+// событию дисбаланса.
+func (r *StatsRepository) GetImbalanceStates(ctx context.Context) (map[string]bool, error) {
+	var rows []struct {
+		TeamName   string `db:"team_name"`
+		Imbalanced bool   `db:"imbalanced"`
+	}
+	err := r.db.SelectContext(ctx, &rows, `
+		SELECT DISTINCT ON (team_name) team_name, imbalanced
+		FROM team_imbalance_events
+		ORDER BY team_name, event_id DESC
+	`)
+	if err != nil {
+		r.logger.Error(ctx, "Failed to get imbalance states", zap.Error(err))
+		return nil, err
+	}
+
+	states := make(map[string]bool, len(rows))
+	for _, row := range rows {
+		states[row.TeamName] = row.Imbalanced
+	}
+	return states, nil
+}
+
+// RecordImbalanceEvents сохраняет события дисбаланса одним запросом.
+func (r *StatsRepository) RecordImbalanceEvents(ctx context.Context, events []entity.ImbalanceEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	teams := make([]string, 0, len(events))
+	imbalanced := make([]bool, 0, len(events))
+	gini := make([]float64, 0, len(events))
+	thresholds := make([]float64, 0, len(events))
+	for _, e := range events {
+		teams = append(teams, e.TeamName)
+		imbalanced = append(imbalanced, e.Imbalanced)
+		gini = append(gini, e.Gini)
+		thresholds = append(thresholds, e.Threshold)
+	}
+
+	if _, err := r.db.ExecContext(ctx, `
+		INSERT INTO team_imbalance_events (team_name, imbalanced, gini, threshold)
+		SELECT * FROM unnest($1::text[], $2::boolean[], $3::float8[], $4::float8[])
+	`, pq.Array(teams), pq.Array(imbalanced), pq.Array(gini), pq.Array(thresholds)); err != nil {
+		r.logger.Error(ctx, "Failed to record imbalance events", zap.Error(err))
+		return err
+	}
+
+	r.logger.Info(ctx, "Imbalance events recorded", zap.Int("events", len(events)))
+	return nil
+}
@@ -28,54 +28,75 @@ func NewTeamRepository(db *sqlx.DB, logger logger.Logger) *TeamRepository {
 	}
 }
 
+// CreateTeam создаёт команду и её участников. Для пользователя из другой
+// команды новая команда становится дополнительной, основная не меняется.
 func (r *TeamRepository) CreateTeam(ctx context.Context, team *entity.Team) error {
 	r.logger.Info(ctx, "Creating team", zap.String("team_name", team.TeamName))
 
-	query := r.sqlBuilder.Select("1").From("teams").Where("team_name = ?", team.TeamName).Limit(1)
-	var exists int
-	err := query.RunWith(r.db).QueryRowContext(ctx).Scan(&exists)
-	if err == nil {
-		r.logger.Warn(ctx, "Team already exists", zap.String("team_name", team.TeamName))
-		return dto.ErrTeamExists
-	} else if !errors.Is(err, sql.ErrNoRows) {
-		r.logger.Error(ctx, "Failed to check existing team", zap.Error(err))
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		r.logger.Error(ctx, "Failed to begin transaction", zap.Error(err))
 		return err
 	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
 
-	_, err = r.sqlBuilder.Insert("teams").Columns("team_name").Values(team.TeamName).RunWith(r.db).ExecContext(ctx)
+	res, err := r.sqlBuilder.Insert("teams").
+		Columns("team_name", "max_reviewers", "max_open_reviews").
+		Values(team.TeamName, team.MaxReviewers, team.MaxOpenReviews).
+		Suffix("ON CONFLICT (team_name) DO NOTHING").
+		RunWith(tx).
+		ExecContext(ctx)
 	if err != nil {
 		r.logger.Error(ctx, "Failed to insert team", zap.Error(err), zap.String("team_name", team.TeamName))
 		return err
 	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		r.logger.Warn(ctx, "Team already exists", zap.String("team_name", team.TeamName))
+		err = dto.ErrTeamExists
+		return err
+	}
 	r.logger.Info(ctx, "Team inserted successfully", zap.String("team_name", team.TeamName))
 
 	for _, member := range team.Members {
-		_, err := r.sqlBuilder.
-			Insert("users").
-			Columns("user_id", "username", "team_name", "is_active").
-			Values(member.UserID, member.Username, team.TeamName, member.IsActive).
-			Suffix("ON CONFLICT (user_id) DO UPDATE SET username = EXCLUDED.username, is_active = EXCLUDED.is_active").
-			RunWith(r.db).
-			ExecContext(ctx)
-		if err != nil {
-			r.logger.Error(ctx, "Failed to insert/update user", zap.String("user_id", member.UserID), zap.Error(err))
+		if err = r.addMember(ctx, tx, team.TeamName, member); err != nil {
 			return err
 		}
-		r.logger.Info(ctx, "User inserted/updated", zap.String("user_id", member.UserID), zap.String("username", member.Username))
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.logger.Error(ctx, "Failed to commit team creation", zap.Error(err))
+		return err
 	}
 
 	return nil
 }
 
+// GetTeamByName возвращает активную команду; архивные команды скрыты.
 func (r *TeamRepository) GetTeamByName(ctx context.Context, name string) (*entity.Team, error) {
+	return r.getTeam(ctx, name, false)
+}
+
+// GetTeamWithArchived возвращает команду вне зависимости от архивации.
+func (r *TeamRepository) GetTeamWithArchived(ctx context.Context, name string) (*entity.Team, error) {
+	return r.getTeam(ctx, name, true)
+}
+
+func (r *TeamRepository) getTeam(ctx context.Context, name string, includeArchived bool) (*entity.Team, error) {
 	name = strings.TrimSpace(name)
-	r.logger.Info(ctx, "Fetching team by name", zap.String("team_name", name))
+	r.logger.Info(ctx, "Fetching team by name", zap.String("team_name", name), zap.Bool("include_archived", includeArchived))
 
 	var team entity.Team
 	teamQuery := r.sqlBuilder.PlaceholderFormat(sq.Dollar).
-		Select("team_name").
+		Select("team_name", "max_reviewers", "max_open_reviews", "archived_at").
 		From("teams").
 		Where(sq.Eq{"team_name": name})
+	if !includeArchived {
+		teamQuery = teamQuery.Where(sq.Eq{"archived_at": nil})
+	}
 
 	teamSQL, teamArgs, err := teamQuery.ToSql()
 	if err != nil {
@@ -83,7 +104,7 @@ func (r *TeamRepository) GetTeamByName(ctx context.Context, name string) (*entit
 		return nil, err
 	}
 
-	err = r.db.QueryRowContext(ctx, teamSQL, teamArgs...).Scan(&team.TeamName)
+	err = r.db.QueryRowContext(ctx, teamSQL, teamArgs...).Scan(&team.TeamName, &team.MaxReviewers, &team.MaxOpenReviews, &team.ArchivedAt)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			r.logger.Warn(ctx, "Team not found", zap.String("team_name", name))
@@ -94,9 +115,11 @@ func (r *TeamRepository) GetTeamByName(ctx context.Context, name string) (*entit
 	}
 
 	usersQuery := r.sqlBuilder.PlaceholderFormat(sq.Dollar).
-		Select("user_id", "username", "team_name", "is_active").
-		From("users").
-		Where(sq.Eq{"team_name": name})
+		Select("u.user_id", "u.username", "u.team_name", "u.is_active AND m.is_active AS is_active", "m.role", "u.max_open_reviews", absentColumn).
+		From("team_memberships m").
+		Join("users u ON u.user_id = m.user_id").
+		Where(sq.Eq{"m.team_name": name}).
+		OrderBy("m.joined_at", "u.user_id")
 
 	usersSQL, usersArgs, err := usersQuery.ToSql()
 	if err != nil {
@@ -127,3 +150,628 @@ func (r *TeamRepository) GetTeamByName(ctx context.Context, name string) (*entit
 
 	return &team, nil
 }
+
+// UpdateTeam применяет добавление, удаление и переименование участников
+// и план переназначения открытых ревью удалённых участников в одной
+// транзакции. Удалённым участникам, для которых команда была основной,
+// основной становится другая их команда либо никакая. Возвращает
+// применённую часть плана.
+func (r *TeamRepository) UpdateTeam(ctx context.Context, update *entity.TeamUpdate, plan []entity.Reassignment) ([]entity.Reassignment, error) {
+	r.logger.Info(ctx, "Updating team", zap.String("team_name", update.TeamName))
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		r.logger.Error(ctx, "Failed to begin transaction", zap.Error(err))
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err = r.lockTeam(ctx, tx, update.TeamName); err != nil {
+		return nil, err
+	}
+
+	for _, member := range update.Add {
+		if err = r.addMember(ctx, tx, update.TeamName, member); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, userID := range update.Remove {
+		var res sql.Result
+		res, err = r.sqlBuilder.Delete("team_memberships").
+			Where(sq.Eq{"team_name": update.TeamName, "user_id": userID}).
+			RunWith(tx).
+			ExecContext(ctx)
+		if err != nil {
+			r.logger.Error(ctx, "Failed to remove member", zap.String("user_id", userID), zap.Error(err))
+			return nil, err
+		}
+		if n, _ := res.RowsAffected(); n == 0 {
+			r.logger.Warn(ctx, "User is not a team member", zap.String("user_id", userID))
+			err = dto.ErrNotTeamMember
+			return nil, err
+		}
+	}
+	if len(update.Remove) > 0 {
+		if err = r.resetPrimaryTeam(ctx, tx, update.TeamName, update.Remove); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, member := range update.Rename {
+		var res sql.Result
+		res, err = r.sqlBuilder.Update("users").
+			Set("username", member.Username).
+			Where(sq.Eq{"user_id": member.UserID}).
+			Where(sq.Expr("EXISTS (SELECT 1 FROM team_memberships m WHERE m.user_id = users.user_id AND m.team_name = ?)", update.TeamName)).
+			RunWith(tx).
+			ExecContext(ctx)
+		if err != nil {
+			r.logger.Error(ctx, "Failed to rename member", zap.String("user_id", member.UserID), zap.Error(err))
+			return nil, err
+		}
+		if n, _ := res.RowsAffected(); n == 0 {
+			r.logger.Warn(ctx, "User is not a team member", zap.String("user_id", member.UserID))
+			err = dto.ErrNotTeamMember
+			return nil, err
+		}
+	}
+
+	applied, err := applyReassignments(ctx, tx, r.logger, plan, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.logger.Error(ctx, "Failed to commit team update", zap.Error(err))
+		return nil, err
+	}
+
+	r.logger.Info(ctx, "Team updated",
+		zap.String("team_name", update.TeamName),
+		zap.Int("added", len(update.Add)),
+		zap.Int("removed", len(update.Remove)),
+		zap.Int("renamed", len(update.Rename)),
+		zap.Int("reassignments", len(applied)),
+	)
+	return applied, nil
+}
+
+// DeleteTeam удаляет команду, у участников которой нет истории PR.
+// Участники остаются в системе в других своих командах либо без команды.
+func (r *TeamRepository) DeleteTeam(ctx context.Context, teamName string) error {
+	r.logger.Info(ctx, "Deleting team", zap.String("team_name", teamName))
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		r.logger.Error(ctx, "Failed to begin transaction", zap.Error(err))
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err = r.lockTeam(ctx, tx, teamName); err != nil {
+		return err
+	}
+
+	var hasHistory bool
+	err = tx.GetContext(ctx, &hasHistory, `
+		SELECT EXISTS(
+			SELECT 1 FROM pull_requests pr
+			JOIN users u ON u.user_id = pr.author_id
+			WHERE u.team_name = $1
+		) OR EXISTS(
+			SELECT 1 FROM pull_request_reviewers rev
+			JOIN users u ON u.user_id = rev.user_id
+			WHERE u.team_name = $1
+		) OR EXISTS(
+			SELECT 1 FROM pull_requests WHERE team_name = $1
+		)`, teamName)
+	if err != nil {
+		r.logger.Error(ctx, "Failed to check team PR history", zap.Error(err))
+		return err
+	}
+	if hasHistory {
+		r.logger.Warn(ctx, "Team has PR history", zap.String("team_name", teamName))
+		err = dto.ErrTeamHasHistory
+		return err
+	}
+
+	_, err = r.sqlBuilder.Delete("teams").
+		Where(sq.Eq{"team_name": teamName}).
+		RunWith(tx).
+		ExecContext(ctx)
+	if err != nil {
+		r.logger.Error(ctx, "Failed to delete team", zap.Error(err))
+		return err
+	}
+
+	if err = r.resetPrimaryTeam(ctx, tx, teamName, nil); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.logger.Error(ctx, "Failed to commit team deletion", zap.Error(err))
+		return err
+	}
+
+	r.logger.Info(ctx, "Team deleted", zap.String("team_name", teamName))
+	return nil
+}
+
+// activeElsewhereCond — у пользователя users.user_id есть активное членство
+// в неархивной команде, кроме переданной параметром.
+const activeElsewhereCond = `EXISTS (
+	SELECT 1 FROM team_memberships m
+	JOIN teams t ON t.team_name = m.team_name
+	WHERE m.user_id = users.user_id AND m.team_name <> ? AND m.is_active AND t.archived_at IS NULL
+)`
+
+// ActiveInOtherTeams возвращает тех из userIDs, кто активен и состоит
+// активным участником в другой неархивной команде, кроме teamName.
+func (r *TeamRepository) ActiveInOtherTeams(ctx context.Context, teamName string, userIDs []string) ([]string, error) {
+	result := []string{}
+	if len(userIDs) == 0 {
+		return result, nil
+	}
+
+	sqlStr, args, err := r.sqlBuilder.Select("user_id").
+		From("users").
+		Where(sq.Eq{"user_id": userIDs, "is_active": true}).
+		Where(sq.Expr(activeElsewhereCond, teamName)).
+		OrderBy("user_id").
+		ToSql()
+	if err != nil {
+		r.logger.Error(ctx, "Failed to build ActiveInOtherTeams query", zap.Error(err))
+		return nil, err
+	}
+
+	if err := r.db.SelectContext(ctx, &result, sqlStr, args...); err != nil {
+		r.logger.Error(ctx, "Failed to fetch users active in other teams", zap.String("team_name", teamName), zap.Error(err))
+		return nil, err
+	}
+	return result, nil
+}
+
+// ArchiveTeam помечает команду архивной, деактивирует членство в ней и в той
+// же транзакции применяет план переназначения открытых ревью участников;
+// ревью без замены снимаются. Участники без активного членства в других
+// командах выключаются целиком, остальным основная команда меняется на
+// другую. Возвращает применённую часть плана.
+func (r *TeamRepository) ArchiveTeam(ctx context.Context, teamName string, plan []entity.Reassignment) ([]entity.Reassignment, error) {
+	r.logger.Info(ctx, "Archiving team", zap.String("team_name", teamName))
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		r.logger.Error(ctx, "Failed to begin transaction", zap.Error(err))
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err = r.lockTeam(ctx, tx, teamName); err != nil {
+		return nil, err
+	}
+
+	_, err = r.sqlBuilder.Update("teams").
+		Set("archived_at", sq.Expr("now()")).
+		Where(sq.Eq{"team_name": teamName}).
+		RunWith(tx).
+		ExecContext(ctx)
+	if err != nil {
+		r.logger.Error(ctx, "Failed to archive team", zap.Error(err))
+		return nil, err
+	}
+
+	_, err = r.sqlBuilder.Update("team_memberships").
+		Set("is_active", false).
+		Where(sq.Eq{"team_name": teamName}).
+		RunWith(tx).
+		ExecContext(ctx)
+	if err != nil {
+		r.logger.Error(ctx, "Failed to deactivate team memberships", zap.Error(err))
+		return nil, err
+	}
+
+	sqlStr, args, err := r.sqlBuilder.Update("users").
+		Set("is_active", false).
+		Where(sq.Expr("user_id IN (SELECT user_id FROM team_memberships WHERE team_name = ?)", teamName)).
+		Where(sq.Expr("NOT "+activeElsewhereCond, teamName)).
+		Suffix("RETURNING user_id").
+		ToSql()
+	if err != nil {
+		r.logger.Error(ctx, "Failed to build deactivate members query", zap.Error(err))
+		return nil, err
+	}
+
+	members := []string{}
+	if err = tx.SelectContext(ctx, &members, sqlStr, args...); err != nil {
+		r.logger.Error(ctx, "Failed to deactivate team members", zap.Error(err))
+		return nil, err
+	}
+
+	sqlStr, args, err = r.sqlBuilder.Select("user_id").
+		From("users").
+		Where(sq.Eq{"team_name": teamName}).
+		Where(sq.Expr(activeElsewhereCond, teamName)).
+		ToSql()
+	if err != nil {
+		r.logger.Error(ctx, "Failed to build staying members query", zap.Error(err))
+		return nil, err
+	}
+
+	staying := []string{}
+	if err = tx.SelectContext(ctx, &staying, sqlStr, args...); err != nil {
+		r.logger.Error(ctx, "Failed to fetch members active in other teams", zap.Error(err))
+		return nil, err
+	}
+	if len(staying) > 0 {
+		if err = r.resetPrimaryTeam(ctx, tx, teamName, staying); err != nil {
+			return nil, err
+		}
+	}
+
+	applied, err := applyReassignments(ctx, tx, r.logger, plan, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.logger.Error(ctx, "Failed to commit team archival", zap.Error(err))
+		return nil, err
+	}
+
+	r.logger.Info(ctx, "Team archived",
+		zap.String("team_name", teamName),
+		zap.Int("members_count", len(members)),
+		zap.Int("reassignments", len(applied)),
+	)
+	return applied, nil
+}
+
+// addMember добавляет пользователя в команду. Новый пользователь получает её
+// как основную; у существующего основная команда меняется, только если
+// её не было.
+func (r *TeamRepository) addMember(ctx context.Context, tx *sqlx.Tx, teamName string, member entity.User) error {
+	_, err := r.sqlBuilder.
+		Insert("users").
+		Columns("user_id", "username", "team_name", "is_active", "max_open_reviews").
+		Values(member.UserID, member.Username, teamName, member.IsActive, member.MaxOpenReviews).
+		Suffix(`ON CONFLICT (user_id) DO UPDATE SET
+			username = EXCLUDED.username,
+			max_open_reviews = COALESCE(EXCLUDED.max_open_reviews, users.max_open_reviews),
+			team_name = CASE WHEN users.team_name = '' THEN EXCLUDED.team_name ELSE users.team_name END,
+			is_active = CASE WHEN users.team_name IN (EXCLUDED.team_name, '') THEN EXCLUDED.is_active ELSE users.is_active END`).
+		RunWith(tx).
+		ExecContext(ctx)
+	if err != nil {
+		r.logger.Error(ctx, "Failed to insert/update user", zap.String("user_id", member.UserID), zap.Error(err))
+		return err
+	}
+
+	role := member.Role
+	if role == "" {
+		role = entity.RoleMember
+	}
+	_, err = r.sqlBuilder.
+		Insert("team_memberships").
+		Columns("team_name", "user_id", "role", "is_active").
+		Values(teamName, member.UserID, role, member.IsActive).
+		Suffix("ON CONFLICT (team_name, user_id) DO UPDATE SET role = EXCLUDED.role, is_active = EXCLUDED.is_active").
+		RunWith(tx).
+		ExecContext(ctx)
+	if err != nil {
+		r.logger.Error(ctx, "Failed to upsert membership", zap.String("user_id", member.UserID), zap.Error(err))
+		return err
+	}
+
+	r.logger.Info(ctx, "User inserted/updated", zap.String("user_id", member.UserID), zap.String("username", member.Username))
+	return nil
+}
+
+// resetPrimaryTeam переносит пользователей, у которых основная команда
+// teamName, в их самую раннюю оставшуюся команду. Пустой userIDs означает
+// всех таких пользователей.
+func (r *TeamRepository) resetPrimaryTeam(ctx context.Context, tx *sqlx.Tx, teamName string, userIDs []string) error {
+	query := r.sqlBuilder.Update("users").
+		Set("team_name", sq.Expr(`COALESCE((
+			SELECT m.team_name FROM team_memberships m
+			WHERE m.user_id = users.user_id AND m.team_name <> ?
+			ORDER BY m.is_active DESC, m.joined_at
+			LIMIT 1
+		), '')`, teamName)).
+		Where(sq.Eq{"team_name": teamName})
+	if len(userIDs) > 0 {
+		query = query.Where(sq.Eq{"user_id": userIDs})
+	}
+
+	if _, err := query.RunWith(tx).ExecContext(ctx); err != nil {
+		r.logger.Error(ctx, "Failed to reset primary team", zap.String("team_name", teamName), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// UpdateMembership меняет роль и/или флаг активности участия в команде.
+func (r *TeamRepository) UpdateMembership(ctx context.Context, teamName, userID string, role *string, isActive *bool) (*entity.Membership, error) {
+	r.logger.Info(ctx, "Updating membership", zap.String("team_name", teamName), zap.String("user_id", userID))
+
+	query := r.sqlBuilder.Update("team_memberships").
+		Where(sq.Eq{"team_name": teamName, "user_id": userID}).
+		Suffix("RETURNING team_name, user_id, role, is_active, joined_at")
+	if role != nil {
+		query = query.Set("role", *role)
+	}
+	if isActive != nil {
+		query = query.Set("is_active", *isActive)
+	}
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		r.logger.Error(ctx, "Failed to build membership update query", zap.Error(err))
+		return nil, err
+	}
+
+	var membership entity.Membership
+	if err := r.db.GetContext(ctx, &membership, sqlStr, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			r.logger.Warn(ctx, "User is not a team member", zap.String("team_name", teamName), zap.String("user_id", userID))
+			return nil, dto.ErrNotTeamMember
+		}
+		r.logger.Error(ctx, "Failed to update membership", zap.Error(err))
+		return nil, err
+	}
+
+	r.logger.Info(ctx, "Membership updated", zap.String("team_name", teamName), zap.String("user_id", userID))
+	return &membership, nil
+}
+
+// GetFallbackTeams возвращает резервные команды в порядке приоритета.
+func (r *TeamRepository) GetFallbackTeams(ctx context.Context, teamName string) ([]string, error) {
+	r.logger.Debug(ctx, "Fetching fallback teams", zap.String("team_name", teamName))
+
+	sqlStr, args, err := r.sqlBuilder.Select("fallback_team").
+		From("team_fallbacks").
+		Where(sq.Eq{"team_name": teamName}).
+		OrderBy("position").
+		ToSql()
+	if err != nil {
+		r.logger.Error(ctx, "Failed to build fallback teams query", zap.Error(err))
+		return nil, err
+	}
+
+	fallbacks := []string{}
+	if err := r.db.SelectContext(ctx, &fallbacks, sqlStr, args...); err != nil {
+		r.logger.Error(ctx, "Failed to fetch fallback teams", zap.Error(err))
+		return nil, err
+	}
+
+	return fallbacks, nil
+}
+
+// SetFallbackTeams заменяет список резервных команд целиком.
+func (r *TeamRepository) SetFallbackTeams(ctx context.Context, teamName string, fallbacks []string) error {
+	r.logger.Info(ctx, "Saving fallback teams", zap.String("team_name", teamName), zap.Strings("fallback_teams", fallbacks))
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		r.logger.Error(ctx, "Failed to begin transaction", zap.Error(err))
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err = r.lockTeam(ctx, tx, teamName); err != nil {
+		return err
+	}
+
+	_, err = r.sqlBuilder.Delete("team_fallbacks").
+		Where(sq.Eq{"team_name": teamName}).
+		RunWith(tx).
+		ExecContext(ctx)
+	if err != nil {
+		r.logger.Error(ctx, "Failed to clear fallback teams", zap.Error(err))
+		return err
+	}
+
+	if len(fallbacks) > 0 {
+		insert := r.sqlBuilder.Insert("team_fallbacks").Columns("team_name", "fallback_team", "position")
+		for i, fallback := range fallbacks {
+			insert = insert.Values(teamName, fallback, i)
+		}
+		if _, err = insert.RunWith(tx).ExecContext(ctx); err != nil {
+			r.logger.Error(ctx, "Failed to insert fallback teams", zap.Error(err))
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.logger.Error(ctx, "Failed to commit fallback teams", zap.Error(err))
+		return err
+	}
+
+	r.logger.Info(ctx, "Fallback teams saved", zap.String("team_name", teamName))
+	return nil
+}
+
+// DeactivateMembers выключает членство пользователей в команде teamName и в
+// той же транзакции применяет план переназначения их открытых ревью.
+// Пользователи и их членство в других командах не затрагиваются.
+// Возвращает применённую часть плана.
+func (r *TeamRepository) DeactivateMembers(ctx context.Context, teamName string, userIDs []string, plan []entity.Reassignment) ([]entity.Reassignment, error) {
+	r.logger.Info(ctx, "Deactivating team members",
+		zap.String("team_name", teamName),
+		zap.Int("users_count", len(userIDs)),
+		zap.Int("planned_reassignments", len(plan)),
+	)
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		r.logger.Error(ctx, "Failed to begin transaction", zap.Error(err))
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err = r.lockTeam(ctx, tx, teamName); err != nil {
+		return nil, err
+	}
+
+	res, err := r.sqlBuilder.Update("team_memberships").
+		Set("is_active", false).
+		Where(sq.Eq{"team_name": teamName, "user_id": userIDs}).
+		RunWith(tx).
+		ExecContext(ctx)
+	if err != nil {
+		r.logger.Error(ctx, "Failed to deactivate team memberships", zap.Error(err))
+		return nil, err
+	}
+	if n, _ := res.RowsAffected(); n < int64(len(userIDs)) {
+		r.logger.Warn(ctx, "Some users are not team members", zap.Int("requested", len(userIDs)), zap.Int64("updated", n))
+		err = dto.ErrNotTeamMember
+		return nil, err
+	}
+
+	applied, err := applyReassignments(ctx, tx, r.logger, plan, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.logger.Error(ctx, "Failed to commit members deactivation", zap.Error(err))
+		return nil, err
+	}
+
+	r.logger.Info(ctx, "Team members deactivated", zap.String("team_name", teamName), zap.Int("reassignments", len(applied)))
+	return applied, nil
+}
+
+// SetMaxOpenReviews задаёт лимит открытых ревью по умолчанию для участников
+// команды; nil снимает лимит.
+func (r *TeamRepository) SetMaxOpenReviews(ctx context.Context, teamName string, limit *int) error {
+	r.logger.Info(ctx, "Setting team max open reviews", zap.String("team_name", teamName))
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		r.logger.Error(ctx, "Failed to begin transaction", zap.Error(err))
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err = r.lockTeam(ctx, tx, teamName); err != nil {
+		return err
+	}
+
+	_, err = r.sqlBuilder.Update("teams").
+		Set("max_open_reviews", limit).
+		Where(sq.Eq{"team_name": teamName}).
+		RunWith(tx).
+		ExecContext(ctx)
+	if err != nil {
+		r.logger.Error(ctx, "Failed to update team max open reviews", zap.Error(err))
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.logger.Error(ctx, "Failed to commit team max open reviews", zap.Error(err))
+		return err
+	}
+
+	r.logger.Info(ctx, "Team max open reviews updated", zap.String("team_name", teamName))
+	return nil
+}
+
+// lockTeam блокирует строку активной команды до конца транзакции.
+func (r *TeamRepository) lockTeam(ctx context.Context, tx *sqlx.Tx, teamName string) error {
+	var archivedAt sql.NullTime
+	err := tx.GetContext(ctx, &archivedAt,
+		"SELECT archived_at FROM teams WHERE team_name=$1 FOR UPDATE",
+		teamName,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			r.logger.Warn(ctx, "Team not found", zap.String("team_name", teamName))
+			return dto.ErrNotFound
+		}
+		r.logger.Error(ctx, "Failed to lock team", zap.String("team_name", teamName), zap.Error(err))
+		return err
+	}
+	if archivedAt.Valid {
+		r.logger.Warn(ctx, "Team is archived", zap.String("team_name", teamName))
+		return dto.ErrTeamArchived
+	}
+
+	return nil
+}
+
+func (r *TeamRepository) GetMergePolicy(ctx context.Context, teamName string) (*entity.MergePolicy, error) {
+	r.logger.Debug(ctx, "Fetching merge policy", zap.String("team_name", teamName))
+
+	query := r.sqlBuilder.
+		Select("team_name", "min_approvals", "block_on_changes_requested", "forbid_author_only_approval", "required_reviewer_team").
+		From("team_merge_policies").
+		Where(sq.Eq{"team_name": teamName})
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		r.logger.Error(ctx, "Failed to build merge policy query", zap.Error(err))
+		return nil, err
+	}
+
+	var policy entity.MergePolicy
+	if err := r.db.GetContext(ctx, &policy, sqlStr, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			r.logger.Debug(ctx, "Merge policy not configured", zap.String("team_name", teamName))
+			return nil, dto.ErrNotFound
+		}
+		r.logger.Error(ctx, "Failed to fetch merge policy", zap.Error(err))
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+func (r *TeamRepository) SetMergePolicy(ctx context.Context, policy *entity.MergePolicy) error {
+	r.logger.Info(ctx, "Saving merge policy", zap.String("team_name", policy.TeamName))
+
+	_, err := r.sqlBuilder.
+		Insert("team_merge_policies").
+		Columns("team_name", "min_approvals", "block_on_changes_requested", "forbid_author_only_approval", "required_reviewer_team").
+		Values(policy.TeamName, policy.MinApprovals, policy.BlockOnChangesRequested, policy.ForbidAuthorOnlyApproval, policy.RequiredReviewerTeam).
+		Suffix(`ON CONFLICT (team_name) DO UPDATE SET
+			min_approvals = EXCLUDED.min_approvals,
+			block_on_changes_requested = EXCLUDED.block_on_changes_requested,
+			forbid_author_only_approval = EXCLUDED.forbid_author_only_approval,
+			required_reviewer_team = EXCLUDED.required_reviewer_team,
+			updated_at = now()`).
+		RunWith(r.db).
+		ExecContext(ctx)
+	if err != nil {
+		r.logger.Error(ctx, "Failed to save merge policy", zap.String("team_name", policy.TeamName), zap.Error(err))
+		return err
+	}
+
+	r.logger.Info(ctx, "Merge policy saved", zap.String("team_name", policy.TeamName))
+	return nil
+}
@@ -7,12 +7,22 @@ import (
 	"pr_reviewer_assignment_service/internal/entity"
 	"pr_reviewer_assignment_service/pkg/logger"
 	"strings"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	"go.uber.org/zap"
 )
 
+const absenceColumns = "absence_id, user_id, starts_at, ends_at, reason, reassign_open_reviews, reassigned_at, created_at"
+
+// absentColumn вычисляет, идёт ли сейчас период отсутствия пользователя u.
+const absentColumn = `EXISTS (
+	SELECT 1 FROM user_absences a
+	WHERE a.user_id = u.user_id AND a.starts_at <= now() AND a.ends_at > now()
+) AS absent`
+
 type UserRepository struct {
 	db     *sqlx.DB
 	sb     sq.StatementBuilderType
@@ -31,8 +41,8 @@ func (r *UserRepository) GetByID(ctx context.Context, userID string) (*entity.Us
 	userID = strings.TrimSpace(userID)
 	r.logger.Info(ctx, "Fetching user by ID", zap.String("user_id", userID))
 
-	query := r.sb.Select("user_id", "username", "team_name", "is_active").
-		From("users").
+	query := r.sb.Select("user_id", "username", "team_name", "is_active", "max_open_reviews", absentColumn).
+		From("users u").
 		Where(sq.Eq{"user_id": userID})
 
 	sqlStr, args, err := query.ToSql()
@@ -61,7 +71,7 @@ func (r *UserRepository) SetIsActive(ctx context.Context, userID string, isActiv
 	query := r.sb.Update("users").
 		Set("is_active", isActive).
 		Where(sq.Eq{"user_id": userID}).
-		Suffix("RETURNING user_id, username, team_name, is_active")
+		Suffix("RETURNING user_id, username, team_name, is_active, max_open_reviews")
 
 	sqlStr, args, err := query.ToSql()
 	if err != nil {
@@ -82,3 +92,420 @@ func (r *UserRepository) SetIsActive(ctx context.Context, userID string, isActiv
 	r.logger.Info(ctx, "User active status updated", zap.String("user_id", u.UserID), zap.Bool("is_active", u.IsActive))
 	return &u, nil
 }
+
+// SetMaxOpenReviews задаёт личный лимит открытых ревью; nil означает, что
+// действует лимит команды.
+func (r *UserRepository) SetMaxOpenReviews(ctx context.Context, userID string, limit *int) (*entity.User, error) {
+	r.logger.Info(ctx, "Updating user max open reviews", zap.String("user_id", userID))
+
+	query := r.sb.Update("users").
+		Set("max_open_reviews", limit).
+		Where(sq.Eq{"user_id": userID}).
+		Suffix("RETURNING user_id, username, team_name, is_active, max_open_reviews")
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		r.logger.Error(ctx, "Failed to build SetMaxOpenReviews query", zap.Error(err))
+		return nil, err
+	}
+
+	var u entity.User
+	if err := r.db.GetContext(ctx, &u, sqlStr, args...); err != nil {
+		if err == sql.ErrNoRows {
+			r.logger.Warn(ctx, "User not found when setting max open reviews", zap.String("user_id", userID))
+			return nil, dto.ErrNotFound
+		}
+		r.logger.Error(ctx, "Failed to update user max open reviews", zap.Error(err))
+		return nil, err
+	}
+
+	r.logger.Info(ctx, "User max open reviews updated", zap.String("user_id", u.UserID))
+	return &u, nil
+}
+
+// MoveUser переводит пользователя в другую основную команду: членство в
+// старой заменяется членством в новой, перевод записывается в
+// user_team_moves. В той же транзакции применяется план переназначения его
+// открытых ревью; пустой план оставляет ревью за ним. Возвращает применённую
+// часть плана.
+func (r *UserRepository) MoveUser(ctx context.Context, userID, toTeam string, plan []entity.Reassignment) (*entity.UserMove, []entity.Reassignment, error) {
+	r.logger.Info(ctx, "Moving user", zap.String("user_id", userID), zap.String("to_team", toTeam))
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		r.logger.Error(ctx, "Failed to begin transaction", zap.Error(err))
+		return nil, nil, err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	var fromTeam string
+	err = tx.GetContext(ctx, &fromTeam, "SELECT team_name FROM users WHERE user_id=$1 FOR UPDATE", userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			r.logger.Warn(ctx, "User not found when moving", zap.String("user_id", userID))
+			err = dto.ErrNotFound
+			return nil, nil, err
+		}
+		r.logger.Error(ctx, "Failed to lock user", zap.Error(err))
+		return nil, nil, err
+	}
+	if fromTeam == toTeam {
+		r.logger.Warn(ctx, "User already in target team", zap.String("user_id", userID), zap.String("team_name", toTeam))
+		err = dto.ErrSameTeam
+		return nil, nil, err
+	}
+
+	var archivedAt sql.NullTime
+	err = tx.GetContext(ctx, &archivedAt, "SELECT archived_at FROM teams WHERE team_name=$1 FOR SHARE", toTeam)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			r.logger.Warn(ctx, "Target team not found", zap.String("team_name", toTeam))
+			err = dto.ErrNotFound
+			return nil, nil, err
+		}
+		r.logger.Error(ctx, "Failed to lock target team", zap.Error(err))
+		return nil, nil, err
+	}
+	if archivedAt.Valid {
+		r.logger.Warn(ctx, "Target team is archived", zap.String("team_name", toTeam))
+		err = dto.ErrTeamArchived
+		return nil, nil, err
+	}
+
+	_, err = r.sb.Update("users").
+		Set("team_name", toTeam).
+		Where(sq.Eq{"user_id": userID}).
+		RunWith(tx).
+		ExecContext(ctx)
+	if err != nil {
+		r.logger.Error(ctx, "Failed to update user team", zap.Error(err))
+		return nil, nil, err
+	}
+
+	_, err = r.sb.Delete("team_memberships").
+		Where(sq.Eq{"team_name": fromTeam, "user_id": userID}).
+		RunWith(tx).
+		ExecContext(ctx)
+	if err != nil {
+		r.logger.Error(ctx, "Failed to remove old membership", zap.Error(err))
+		return nil, nil, err
+	}
+
+	_, err = r.sb.Insert("team_memberships").
+		Columns("team_name", "user_id").
+		Values(toTeam, userID).
+		Suffix("ON CONFLICT (team_name, user_id) DO NOTHING").
+		RunWith(tx).
+		ExecContext(ctx)
+	if err != nil {
+		r.logger.Error(ctx, "Failed to add new membership", zap.Error(err))
+		return nil, nil, err
+	}
+
+	sqlStr, args, err := r.sb.Insert("user_team_moves").
+		Columns("user_id", "from_team", "to_team").
+		Values(userID, fromTeam, toTeam).
+		Suffix("RETURNING user_id, from_team, to_team, moved_at").
+		ToSql()
+	if err != nil {
+		r.logger.Error(ctx, "Failed to build move record query", zap.Error(err))
+		return nil, nil, err
+	}
+
+	var move entity.UserMove
+	if err = tx.GetContext(ctx, &move, sqlStr, args...); err != nil {
+		r.logger.Error(ctx, "Failed to record user move", zap.Error(err))
+		return nil, nil, err
+	}
+
+	applied, err := applyReassignments(ctx, tx, r.logger, plan, false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.logger.Error(ctx, "Failed to commit user move", zap.Error(err))
+		return nil, nil, err
+	}
+
+	r.logger.Info(ctx, "User moved",
+		zap.String("user_id", userID),
+		zap.String("from_team", fromTeam),
+		zap.String("to_team", toTeam),
+		zap.Int("reassignments", len(applied)),
+	)
+	return &move, applied, nil
+}
+
+// AddAbsence сохраняет период отсутствия пользователя.
+func (r *UserRepository) AddAbsence(ctx context.Context, absence *entity.Absence) (*entity.Absence, error) {
+	r.logger.Info(ctx, "Adding absence", zap.String("user_id", absence.UserID))
+
+	query := `
+		INSERT INTO user_absences (user_id, starts_at, ends_at, reason, reassign_open_reviews)
+		SELECT user_id, $2, $3, $4, $5 FROM users WHERE user_id = $1
+		RETURNING ` + absenceColumns
+
+	var created entity.Absence
+	err := r.db.GetContext(ctx, &created, query,
+		absence.UserID, absence.StartsAt, absence.EndsAt, absence.Reason, absence.ReassignOpenReviews,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			r.logger.Warn(ctx, "User not found when adding absence", zap.String("user_id", absence.UserID))
+			return nil, dto.ErrNotFound
+		}
+		r.logger.Error(ctx, "Failed to add absence", zap.Error(err))
+		return nil, err
+	}
+
+	r.logger.Info(ctx, "Absence added", zap.String("user_id", created.UserID), zap.Int64("absence_id", created.AbsenceID))
+	return &created, nil
+}
+
+// GetAbsences возвращает периоды отсутствия пользователя, которые ещё не
+// закончились.
+func (r *UserRepository) GetAbsences(ctx context.Context, userID string) ([]entity.Absence, error) {
+	r.logger.Debug(ctx, "GetAbsences called", zap.String("user_id", userID))
+
+	sqlStr, args, err := r.sb.Select(absenceColumns).
+		From("user_absences").
+		Where(sq.Eq{"user_id": userID}).
+		Where("ends_at > now()").
+		OrderBy("starts_at", "absence_id").
+		ToSql()
+	if err != nil {
+		r.logger.Error(ctx, "Failed to build GetAbsences query", zap.Error(err))
+		return nil, err
+	}
+
+	absences := []entity.Absence{}
+	if err := r.db.SelectContext(ctx, &absences, sqlStr, args...); err != nil {
+		r.logger.Error(ctx, "Failed to fetch absences", zap.Error(err))
+		return nil, err
+	}
+
+	return absences, nil
+}
+
+func (r *UserRepository) DeleteAbsence(ctx context.Context, absenceID int64) error {
+	r.logger.Info(ctx, "Deleting absence", zap.Int64("absence_id", absenceID))
+
+	res, err := r.sb.Delete("user_absences").
+		Where(sq.Eq{"absence_id": absenceID}).
+		RunWith(r.db).
+		ExecContext(ctx)
+	if err != nil {
+		r.logger.Error(ctx, "Failed to delete absence", zap.Error(err))
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		r.logger.Warn(ctx, "Absence not found", zap.Int64("absence_id", absenceID))
+		return dto.ErrNotFound
+	}
+
+	return nil
+}
+
+// GetStartedAbsences возвращает идущие в момент now периоды отсутствия, для
+// которых запрошено, но ещё не выполнено переназначение открытых ревью.
+func (r *UserRepository) GetStartedAbsences(ctx context.Context, now time.Time) ([]entity.Absence, error) {
+	sqlStr, args, err := r.sb.Select(absenceColumns).
+		From("user_absences").
+		Where(sq.Eq{"reassign_open_reviews": true, "reassigned_at": nil}).
+		Where(sq.LtOrEq{"starts_at": now}).
+		Where(sq.Gt{"ends_at": now}).
+		OrderBy("starts_at", "absence_id").
+		ToSql()
+	if err != nil {
+		r.logger.Error(ctx, "Failed to build GetStartedAbsences query", zap.Error(err))
+		return nil, err
+	}
+
+	absences := []entity.Absence{}
+	if err := r.db.SelectContext(ctx, &absences, sqlStr, args...); err != nil {
+		r.logger.Error(ctx, "Failed to fetch started absences", zap.Error(err))
+		return nil, err
+	}
+
+	return absences, nil
+}
+
+// MarkAbsenceReassigned в одной транзакции применяет план переназначения
+// открытых ревью отсутствующего пользователя и помечает период
+// обработанным. Возвращает применённую часть плана.
+func (r *UserRepository) MarkAbsenceReassigned(ctx context.Context, absenceID int64, at time.Time, plan []entity.Reassignment) ([]entity.Reassignment, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		r.logger.Error(ctx, "Failed to begin transaction", zap.Error(err))
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	res, err := r.sb.Update("user_absences").
+		Set("reassigned_at", at).
+		Where(sq.Eq{"absence_id": absenceID}).
+		Where("reassigned_at IS NULL").
+		RunWith(tx).
+		ExecContext(ctx)
+	if err != nil {
+		r.logger.Error(ctx, "Failed to mark absence reassigned", zap.Int64("absence_id", absenceID), zap.Error(err))
+		return nil, err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		r.logger.Error(ctx, "Failed to get rows affected", zap.Error(err))
+		return nil, err
+	}
+	if affected == 0 {
+		r.logger.Warn(ctx, "Absence not found or already processed", zap.Int64("absence_id", absenceID))
+		err = dto.ErrNotFound
+		return nil, err
+	}
+
+	applied, err := applyReassignments(ctx, tx, r.logger, plan, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.logger.Error(ctx, "Failed to commit absence reassignment", zap.Error(err))
+		return nil, err
+	}
+
+	return applied, nil
+}
+
+// Deactivate выключает пользователя и в той же транзакции применяет план
+// переназначения его открытых ревью. Возвращает применённую часть плана.
+func (r *UserRepository) Deactivate(ctx context.Context, userID string, plan []entity.Reassignment) (*entity.User, []entity.Reassignment, error) {
+	r.logger.Info(ctx, "Deactivating user", zap.String("user_id", userID), zap.Int("planned_reassignments", len(plan)))
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		r.logger.Error(ctx, "Failed to begin transaction", zap.Error(err))
+		return nil, nil, err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	applied, err := deactivateWithReassignments(ctx, tx, r.logger, []string{userID}, plan)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var u entity.User
+	err = tx.GetContext(ctx, &u,
+		"SELECT user_id, username, team_name, is_active, max_open_reviews FROM users WHERE user_id=$1",
+		userID,
+	)
+	if err != nil {
+		r.logger.Error(ctx, "Failed to fetch deactivated user", zap.Error(err))
+		return nil, nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.logger.Error(ctx, "Failed to commit deactivation", zap.Error(err))
+		return nil, nil, err
+	}
+
+	r.logger.Info(ctx, "User deactivated", zap.String("user_id", userID), zap.Int("reassignments", len(applied)))
+	return &u, applied, nil
+}
+
+// SetOwnershipPatterns заменяет шаблоны владения пользователя на patterns с
+// сохранением их порядка.
+func (r *UserRepository) SetOwnershipPatterns(ctx context.Context, userID string, patterns []string) error {
+	r.logger.Info(ctx, "Setting ownership patterns", zap.String("user_id", userID), zap.Int("patterns_count", len(patterns)))
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		r.logger.Error(ctx, "Failed to begin transaction", zap.Error(err))
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	var locked string
+	if err = tx.GetContext(ctx, &locked, `SELECT user_id FROM users WHERE user_id = $1 FOR UPDATE`, userID); err != nil {
+		if err == sql.ErrNoRows {
+			r.logger.Warn(ctx, "User not found when setting ownership patterns", zap.String("user_id", userID))
+			err = dto.ErrNotFound
+			return err
+		}
+		r.logger.Error(ctx, "Failed to lock user", zap.String("user_id", userID), zap.Error(err))
+		return err
+	}
+
+	if _, err = tx.ExecContext(ctx, `DELETE FROM user_ownership_patterns WHERE user_id = $1`, userID); err != nil {
+		r.logger.Error(ctx, "Failed to delete ownership patterns", zap.Error(err))
+		return err
+	}
+
+	if len(patterns) > 0 {
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO user_ownership_patterns (user_id, pattern, position)
+			SELECT $1, p.pattern, p.position FROM unnest($2::text[]) WITH ORDINALITY AS p(pattern, position)
+		`, userID, pq.Array(patterns))
+		if err != nil {
+			r.logger.Error(ctx, "Failed to insert ownership patterns", zap.Error(err))
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.logger.Error(ctx, "Failed to commit ownership patterns", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// GetOwnershipPatterns возвращает шаблоны владения пользователей userIDs.
+// Пользователи без шаблонов в результат не попадают.
+func (r *UserRepository) GetOwnershipPatterns(ctx context.Context, userIDs []string) (map[string][]string, error) {
+	r.logger.Debug(ctx, "GetOwnershipPatterns called", zap.Int("users_count", len(userIDs)))
+
+	result := make(map[string][]string)
+	if len(userIDs) == 0 {
+		return result, nil
+	}
+
+	sqlStr, args, err := r.sb.Select("user_id", "pattern").
+		From("user_ownership_patterns").
+		Where(sq.Eq{"user_id": userIDs}).
+		OrderBy("user_id", "position").
+		ToSql()
+	if err != nil {
+		r.logger.Error(ctx, "Failed to build GetOwnershipPatterns query", zap.Error(err))
+		return nil, err
+	}
+
+	var rows []struct {
+		UserID  string `db:"user_id"`
+		Pattern string `db:"pattern"`
+	}
+	if err := r.db.SelectContext(ctx, &rows, sqlStr, args...); err != nil {
+		r.logger.Error(ctx, "Failed to fetch ownership patterns", zap.Error(err))
+		return nil, err
+	}
+
+	for _, row := range rows {
+		result[row.UserID] = append(result[row.UserID], row.Pattern)
+	}
+	return result, nil
+}
@@ -15,14 +15,43 @@ func (s *Server) registerRoutes() {
 	userHandler := handlers.NewUserHandler(s.userService)
 	prHandler := handlers.NewPRHandler(s.prService)
 	teamHandler := handlers.NewTeamHandler(s.teamService)
+	statsHandler := handlers.NewStatsHandler(s.statsService)
 
 	s.mux.Handle("/users/set-active", logMiddleware(http.HandlerFunc(userHandler.SetActive)))
 	s.mux.Handle("/users/get-review", logMiddleware(http.HandlerFunc(userHandler.GetReview)))
+	s.mux.Handle("/users/move", logMiddleware(http.HandlerFunc(userHandler.MoveUser)))
+	s.mux.Handle("/users/set-max-open-reviews", logMiddleware(http.HandlerFunc(userHandler.SetMaxOpenReviews)))
+	s.mux.Handle("/users/set-ownership", logMiddleware(http.HandlerFunc(userHandler.SetOwnershipPatterns)))
+	s.mux.Handle("/users/get-ownership", logMiddleware(http.HandlerFunc(userHandler.GetOwnershipPatterns)))
+	s.mux.Handle("/users/absence/add", logMiddleware(http.HandlerFunc(userHandler.AddAbsence)))
+	s.mux.Handle("/users/absence/get", logMiddleware(http.HandlerFunc(userHandler.GetAbsences)))
+	s.mux.Handle("/users/absence/delete", logMiddleware(http.HandlerFunc(userHandler.DeleteAbsence)))
+
+	s.mux.Handle("/codeowners/import", logMiddleware(http.HandlerFunc(userHandler.ImportCodeowners)))
 
 	s.mux.Handle("/pull-request/create", logMiddleware(http.HandlerFunc(prHandler.CreatePR)))
 	s.mux.Handle("/pull-request/merge", logMiddleware(http.HandlerFunc(prHandler.MergePR)))
 	s.mux.Handle("/pull-request/reassign", logMiddleware(http.HandlerFunc(prHandler.ReassignPR)))
+	s.mux.Handle("/pull-request/close", logMiddleware(http.HandlerFunc(prHandler.ClosePR)))
+	s.mux.Handle("/pull-request/reopen", logMiddleware(http.HandlerFunc(prHandler.ReopenPR)))
+	s.mux.Handle("/pull-request/ready", logMiddleware(http.HandlerFunc(prHandler.MarkReady)))
+	s.mux.Handle("/pull-request/review", logMiddleware(http.HandlerFunc(prHandler.SubmitReview)))
+	s.mux.Handle("/pull-request/get", logMiddleware(http.HandlerFunc(prHandler.GetPR)))
+	s.mux.Handle("/pull-request/list", logMiddleware(http.HandlerFunc(prHandler.ListPRs)))
 
 	s.mux.Handle("/team/add", logMiddleware(http.HandlerFunc(teamHandler.CreateTeam)))
 	s.mux.Handle("/team/get", logMiddleware(http.HandlerFunc(teamHandler.GetTeam)))
+	s.mux.Handle("/team/update", logMiddleware(http.HandlerFunc(teamHandler.UpdateTeam)))
+	s.mux.Handle("/team/delete", logMiddleware(http.HandlerFunc(teamHandler.DeleteTeam)))
+	s.mux.Handle("/team/deactivate-users", logMiddleware(http.HandlerFunc(teamHandler.DeactivateUsers)))
+	s.mux.Handle("/team/set-membership", logMiddleware(http.HandlerFunc(teamHandler.SetMembership)))
+	s.mux.Handle("/team/set-max-open-reviews", logMiddleware(http.HandlerFunc(teamHandler.SetMaxOpenReviews)))
+	s.mux.Handle("/team/set-fallbacks", logMiddleware(http.HandlerFunc(teamHandler.SetFallbackTeams)))
+	s.mux.Handle("/team/get-fallbacks", logMiddleware(http.HandlerFunc(teamHandler.GetFallbackTeams)))
+	s.mux.Handle("/team/set-merge-policy", logMiddleware(http.HandlerFunc(teamHandler.SetMergePolicy)))
+	s.mux.Handle("/team/get-merge-policy", logMiddleware(http.HandlerFunc(teamHandler.GetMergePolicy)))
+
+	s.mux.Handle("/stats/reviewers", logMiddleware(http.HandlerFunc(statsHandler.GetReviewerStats)))
+	s.mux.Handle("/stats/latency", logMiddleware(http.HandlerFunc(statsHandler.GetLatency)))
+	s.mux.Handle("/stats/fairness", logMiddleware(http.HandlerFunc(statsHandler.GetFairness)))
 }
@@ -7,6 +7,7 @@ import (
 
 	"pr_reviewer_assignment_service/internal/config"
 	usecasePr "pr_reviewer_assignment_service/internal/usecase/pr"
+	usecaseStats "pr_reviewer_assignment_service/internal/usecase/stats"
 	usecaseTeam "pr_reviewer_assignment_service/internal/usecase/team"
 	usecaseUser "pr_reviewer_assignment_service/internal/usecase/user"
 
@@ -14,28 +15,31 @@ import (
 )
 
 type Server struct {
-	mux         *http.ServeMux
-	logger      logger.Logger
-	userService *usecaseUser.UserService
-	prService   *usecasePr.PRService
-	teamService *usecaseTeam.TeamService
-	httpServer  *http.Server
+	mux          *http.ServeMux
+	logger       logger.Logger
+	userService  *usecaseUser.UserService
+	prService    *usecasePr.PRService
+	teamService  *usecaseTeam.TeamService
+	statsService *usecaseStats.StatsService
+	httpServer   *http.Server
 }
 
 func NewServer(cfg *config.Config, l logger.Logger,
 	userSvc *usecaseUser.UserService,
 	prSvc *usecasePr.PRService,
 	teamSvc *usecaseTeam.TeamService,
+	statsSvc *usecaseStats.StatsService,
 ) *Server {
 
 	mux := http.NewServeMux()
 
 	s := &Server{
-		mux:         mux,
-		logger:      l,
-		userService: userSvc,
-		prService:   prSvc,
-		teamService: teamSvc,
+		mux:          mux,
+		logger:       l,
+		userService:  userSvc,
+		prService:    prSvc,
+		teamService:  teamSvc,
+		statsService: statsSvc,
 	}
 
 	s.registerRoutes()
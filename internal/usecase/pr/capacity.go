@@ -0,0 +1,76 @@
+package usecase
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	"pr_reviewer_assignment_service/internal/dto"
+	"pr_reviewer_assignment_service/internal/entity"
+
+	"go.uber.org/zap"
+)
+
+// withinCapacity отбрасывает кандидатов из team, у которых открытых ревью уже
+// не меньше лимита. Второе значение сообщает, что кто-то был отброшен.
+func (s *PRService) withinCapacity(ctx context.Context, team *entity.Team, candidates []string) ([]string, bool, error) {
+	limits := map[string]int{}
+	for _, member := range team.Members {
+		if !slices.Contains(candidates, member.UserID) {
+			continue
+		}
+		if limit := openReviewsLimit(&member, team); limit != nil {
+			limits[member.UserID] = *limit
+		}
+	}
+	if len(limits) == 0 {
+		return candidates, false, nil
+	}
+
+	loads, err := s.repo.CountOpenReviews(ctx, slices.Sorted(maps.Keys(limits)))
+	if err != nil {
+		s.logger.Error(ctx, "Failed to count open reviews", zap.String("team_name", team.TeamName), zap.Error(err))
+		return nil, false, err
+	}
+
+	result := []string{}
+	capped := false
+	for _, userID := range candidates {
+		if limit, ok := limits[userID]; ok && loads[userID] >= limit {
+			s.logger.Debug(ctx, "Candidate at capacity", zap.String("user_id", userID), zap.Int("open_reviews", loads[userID]))
+			capped = true
+			continue
+		}
+		result = append(result, userID)
+	}
+
+	return result, capped, nil
+}
+
+// checkCapacity проверяет, что явно выбранный ревьювер не упёрся в лимит
+// открытых ревью: личный, а если его нет — лимит команды PR team.
+func (s *PRService) checkCapacity(ctx context.Context, reviewer *entity.User, team *entity.Team) error {
+	limit := openReviewsLimit(reviewer, team)
+	if limit == nil {
+		return nil
+	}
+
+	loads, err := s.repo.CountOpenReviews(ctx, []string{reviewer.UserID})
+	if err != nil {
+		return err
+	}
+	if loads[reviewer.UserID] >= *limit {
+		return dto.ErrReviewerAtLimit
+	}
+
+	return nil
+}
+
+// openReviewsLimit возвращает личный лимит участника, а если его нет — лимит
+// команды. nil означает отсутствие лимита.
+func openReviewsLimit(member *entity.User, team *entity.Team) *int {
+	if member.MaxOpenReviews != nil {
+		return member.MaxOpenReviews
+	}
+	return team.MaxOpenReviews
+}
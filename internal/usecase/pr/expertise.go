@@ -0,0 +1,140 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	"pr_reviewer_assignment_service/internal/entity"
+	"pr_reviewer_assignment_service/pkg/codeowners"
+
+	"go.uber.org/zap"
+)
+
+// changeSet — изменённые файлы PR и репозиторий, по CODEOWNERS которого
+// определяются их владельцы. owners заполняется withCodeowners один раз на
+// подбор ревьюверов.
+type changeSet struct {
+	repository string
+	files      []string
+	owners     map[string]bool
+}
+
+// withCodeowners загружает правила CODEOWNERS репозитория PR и вычисляет
+// владельцев изменённых файлов.
+func (s *PRService) withCodeowners(ctx context.Context, changes changeSet) (changeSet, error) {
+	if len(changes.files) == 0 {
+		return changes, nil
+	}
+
+	rules, err := s.userRepo.GetCodeownersRules(ctx, changes.repository)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get CODEOWNERS rules", zap.String("repository", changes.repository), zap.Error(err))
+		return changes, err
+	}
+	changes.owners = fileOwners(rules, changes.files)
+	return changes, nil
+}
+
+// pick выбирает count ревьюверов из candidates стратегией сервиса, начиная с
+// владельцев изменённых файлов. Если владельцев среди кандидатов нет или их
+// не хватает, остальные места заполняются обычным образом.
+func (s *PRService) pick(ctx context.Context, teamName string, candidates []string, count int, changes changeSet) ([]string, error) {
+	experts, err := s.expertsAmong(ctx, candidates, changes)
+	if err != nil {
+		return nil, err
+	}
+	if len(experts) == 0 {
+		return s.selector.Select(ctx, teamName, candidates, count)
+	}
+
+	var owners, others []string
+	for _, candidate := range candidates {
+		if experts[candidate] {
+			owners = append(owners, candidate)
+		} else {
+			others = append(others, candidate)
+		}
+	}
+
+	reviewers, err := s.selector.SelectRanked(ctx, teamName, [][]string{owners, others}, count)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Debug(ctx, "Expertise-based selection",
+		zap.String("team_name", teamName),
+		zap.Strings("owners", owners),
+		zap.Strings("reviewers", reviewers),
+	)
+	return reviewers, nil
+}
+
+// expertsAmong возвращает кандидатов, владеющих хотя бы одним из файлов —
+// по личным шаблонам владения или по импортированному CODEOWNERS
+// репозитория PR.
+func (s *PRService) expertsAmong(ctx context.Context, candidates []string, changes changeSet) (map[string]bool, error) {
+	if len(candidates) == 0 || len(changes.files) == 0 {
+		return nil, nil
+	}
+
+	patterns, err := s.userRepo.GetOwnershipPatterns(ctx, candidates)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get ownership patterns", zap.Error(err))
+		return nil, err
+	}
+
+	experts := make(map[string]bool)
+	for _, candidate := range candidates {
+		if changes.owners[candidate] || codeowners.MatchAny(patterns[candidate], changes.files) {
+			experts[candidate] = true
+		}
+	}
+	return experts, nil
+}
+
+// fileOwners возвращает владельцев файлов по правилам CODEOWNERS: как и в
+// GitHub, для каждого файла действует последнее подходящее правило.
+func fileOwners(rules []entity.CodeownersRule, files []string) map[string]bool {
+	owners := make(map[string]bool)
+	if len(rules) == 0 {
+		return owners
+	}
+
+	compiled := make([]*codeowners.Pattern, len(rules))
+	for i, rule := range rules {
+		compiled[i], _ = codeowners.Compile(rule.Pattern)
+	}
+
+	for _, file := range files {
+		for i := len(rules) - 1; i >= 0; i-- {
+			if compiled[i] == nil || !compiled[i].Match(file) {
+				continue
+			}
+			for _, userID := range rules[i].UserIDs {
+				owners[userID] = true
+			}
+			break
+		}
+	}
+	return owners
+}
+
+// normalizePaths приводит пути файлов к виду относительно корня репозитория,
+// отбрасывая пустые и повторяющиеся.
+func normalizePaths(paths []string) []string {
+	if len(paths) == 0 {
+		return nil
+	}
+
+	seen := make(map[string]bool, len(paths))
+	result := make([]string, 0, len(paths))
+	for _, path := range paths {
+		path = strings.TrimPrefix(strings.TrimSpace(path), "/")
+		if path == "" || seen[path] {
+			continue
+		}
+		seen[path] = true
+		result = append(result, path)
+	}
+	return result
+}
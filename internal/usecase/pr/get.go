@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"pr_reviewer_assignment_service/internal/dto"
+	"pr_reviewer_assignment_service/internal/dto/pr"
+	"pr_reviewer_assignment_service/internal/entity"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// GetPR возвращает PR с автором и ревьюверами. Для PR без своей команды
+// TeamName — основная команда автора.
+func (s *PRService) GetPR(ctx context.Context, prID string) (*pr.PRDetailResponse, error) {
+	s.logger.Info(ctx, "GetPR called", zap.String("pull_request_id", prID))
+
+	if _, err := uuid.Parse(prID); err != nil {
+		s.logger.Warn(ctx, "Malformed pull request id", zap.String("pull_request_id", prID))
+		return nil, dto.ErrNotFound
+	}
+
+	prEntity, err := s.repo.GetByID(ctx, prID)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get PR", zap.String("pull_request_id", prID), zap.Error(err))
+		return nil, err
+	}
+
+	var author *entity.User
+	if prEntity.AuthorID != "" {
+		author, err = s.userRepo.GetByID(ctx, prEntity.AuthorID)
+		if err != nil && !errors.Is(err, dto.ErrNotFound) {
+			s.logger.Error(ctx, "Failed to get PR author", zap.String("author_id", prEntity.AuthorID), zap.Error(err))
+			return nil, err
+		}
+	}
+
+	return toPRDetailResponse(prEntity, author), nil
+}
+
+func toPRDetailResponse(p *entity.PullRequest, author *entity.User) *pr.PRDetailResponse {
+	resp := &pr.PRDetailResponse{
+		PullRequestID:   p.PullRequestID,
+		PullRequestName: p.Name,
+		AuthorID:        p.AuthorID,
+		TeamName:        p.TeamName,
+		Status:          string(p.Status),
+		Reviewers:       make([]pr.ReviewerDTO, 0, len(p.Reviews)),
+		UnderStaffed:    p.UnderStaffed,
+		Repository:      p.Repository,
+		ChangedFiles:    p.ChangedFiles,
+		CreatedAt:       formatTime(p.CreatedAt),
+		MergedAt:        formatTime(p.MergedAt),
+	}
+
+	if author != nil {
+		resp.Author = &pr.AuthorDTO{
+			UserID:   author.UserID,
+			Username: author.Username,
+			TeamName: author.TeamName,
+			IsActive: author.IsActive,
+		}
+		if resp.TeamName == "" {
+			resp.TeamName = author.TeamName
+		}
+	}
+
+	for _, review := range p.Reviews {
+		resp.Reviewers = append(resp.Reviewers, pr.ReviewerDTO{
+			UserID:     review.UserID,
+			Username:   review.Username,
+			AssignedAt: formatTime(review.AssignedAt),
+			Verdict:    string(review.Verdict),
+			VerdictAt:  formatTime(review.VerdictAt),
+			Fallback:   review.Fallback,
+		})
+	}
+
+	return resp
+}
@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+
+	"pr_reviewer_assignment_service/internal/dto"
+	"pr_reviewer_assignment_service/internal/dto/pr"
+	"pr_reviewer_assignment_service/internal/entity"
+
+	"go.uber.org/zap"
+)
+
+// transitions описывает допустимые переходы статусов PR. MERGED — конечный
+// статус, CLOSED можно только переоткрыть.
+var transitions = map[entity.PRStatus][]entity.PRStatus{
+	entity.StatusDraft:  {entity.StatusOpen, entity.StatusClosed},
+	entity.StatusOpen:   {entity.StatusMerged, entity.StatusClosed},
+	entity.StatusClosed: {entity.StatusOpen},
+}
+
+func canTransition(from, to entity.PRStatus) bool {
+	return slices.Contains(transitions[from], to)
+}
+
+// MarkReady переводит черновик в OPEN и назначает ревьюверов.
+func (s *PRService) MarkReady(ctx context.Context, req *pr.TransitionRequest) (*pr.PRResponse, error) {
+	return s.transition(ctx, req.PullRequestID, entity.StatusDraft, entity.StatusOpen)
+}
+
+// ClosePR закрывает PR без merge и снимает с него всех ревьюверов.
+func (s *PRService) ClosePR(ctx context.Context, req *pr.TransitionRequest) (*pr.PRResponse, error) {
+	return s.transition(ctx, req.PullRequestID, "", entity.StatusClosed)
+}
+
+// ReopenPR возвращает закрытый PR в OPEN с заново подобранными ревьюверами.
+func (s *PRService) ReopenPR(ctx context.Context, req *pr.TransitionRequest) (*pr.PRResponse, error) {
+	return s.transition(ctx, req.PullRequestID, entity.StatusClosed, entity.StatusOpen)
+}
+
+// transition выполняет переход в статус to. Если from не пуст, переход
+// разрешён только из этого статуса.
+func (s *PRService) transition(ctx context.Context, prID string, from, to entity.PRStatus) (*pr.PRResponse, error) {
+	s.logger.Info(ctx, "PR transition called", zap.String("pull_request_id", prID), zap.String("to", string(to)))
+
+	prEntity, err := s.repo.GetByID(ctx, prID)
+	if err != nil {
+		s.logger.Error(ctx, "PR not found", zap.String("pull_request_id", prID), zap.Error(err))
+		return nil, err
+	}
+
+	current := prEntity.Status
+	if (from != "" && current != from) || !canTransition(current, to) {
+		s.logger.Warn(ctx, "Invalid PR transition",
+			zap.String("pull_request_id", prID),
+			zap.String("from", string(current)),
+			zap.String("to", string(to)),
+		)
+		return nil, dto.ErrInvalidTransition
+	}
+
+	prEntity.Status = to
+	prEntity.AssignedReviewers = []string{}
+	prEntity.FallbackReviewers = nil
+	prEntity.UnderStaffed = false
+	if to == entity.StatusOpen {
+		author, err := s.userRepo.GetByID(ctx, prEntity.AuthorID)
+		if err != nil {
+			s.logger.Error(ctx, "Author not found", zap.String("author_id", prEntity.AuthorID), zap.Error(err))
+			return nil, dto.ErrNotFound
+		}
+
+		teamName := prEntity.TeamName
+		if teamName == "" {
+			teamName = author.TeamName
+		}
+		team, err := s.teamRepo.GetTeamByName(ctx, teamName)
+		if err != nil {
+			s.logger.Error(ctx, "Team not found", zap.String("team_name", teamName), zap.Error(err))
+			return nil, dto.ErrNotFound
+		}
+
+		selection, err := s.selectReviewers(ctx, author, team, nil, changeSet{repository: prEntity.Repository, files: prEntity.ChangedFiles})
+		if err != nil {
+			return nil, err
+		}
+		prEntity.AssignedReviewers = selection.reviewers
+		prEntity.FallbackReviewers = selection.fallback
+		prEntity.UnderStaffed = selection.underStaffed
+	}
+
+	if err := s.repo.UpdateStatus(ctx, prEntity, current); err != nil {
+		s.logger.Error(ctx, "Failed to update PR status", zap.String("pull_request_id", prID), zap.Error(err))
+		return nil, err
+	}
+
+	s.logger.Info(ctx, "PR transitioned",
+		zap.String("pull_request_id", prID),
+		zap.String("from", string(current)),
+		zap.String("to", string(to)),
+	)
+
+	return toPRResponse(prEntity), nil
+}
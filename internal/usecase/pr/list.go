@@ -0,0 +1,254 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"pr_reviewer_assignment_service/internal/dto"
+	"pr_reviewer_assignment_service/internal/dto/pr"
+	"pr_reviewer_assignment_service/internal/entity"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultListLimit = 50
+	maxListLimit     = 200
+)
+
+// listCursor — содержимое next_cursor. Сортировка и хэш фильтров зашиты в
+// курсор, чтобы его нельзя было применить к списку в другом порядке или с
+// другими фильтрами.
+type listCursor struct {
+	SortBy  entity.PRSortField `json:"sort"`
+	Desc    bool               `json:"desc"`
+	Filters string             `json:"filters"`
+	At      time.Time          `json:"at"`
+	ID      string             `json:"id"`
+}
+
+// ListPRs возвращает страницу PR по фильтрам запроса. По умолчанию — самые
+// новые первыми; next_cursor отсутствует на последней странице.
+func (s *PRService) ListPRs(ctx context.Context, req *pr.ListPRsRequest) (*pr.ListPRsResponse, error) {
+	s.logger.Info(ctx, "ListPRs called",
+		zap.Strings("statuses", req.Statuses),
+		zap.String("author_id", req.AuthorID),
+		zap.String("reviewer_id", req.ReviewerID),
+		zap.String("team_name", req.TeamName),
+		zap.String("sort_by", req.SortBy),
+		zap.String("order", req.Order),
+	)
+
+	filter, err := s.parseListFilter(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	limit := filter.Limit
+	filter.Limit++
+
+	prs, err := s.repo.List(ctx, filter)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to list PRs", zap.Error(err))
+		return nil, err
+	}
+
+	resp := &pr.ListPRsResponse{PullRequests: make([]pr.PRResponse, 0, min(len(prs), limit))}
+	if len(prs) > limit {
+		prs = prs[:limit]
+		last := prs[len(prs)-1]
+		at := last.CreatedAt
+		if filter.SortBy == entity.SortByMergedAt {
+			at = last.MergedAt
+		}
+		if at != nil {
+			cursor, err := encodeCursor(listCursor{SortBy: filter.SortBy, Desc: filter.Desc, Filters: filtersHash(filter), At: *at, ID: last.PullRequestID})
+			if err != nil {
+				s.logger.Error(ctx, "Failed to encode list cursor", zap.Error(err))
+				return nil, err
+			}
+			resp.NextCursor = &cursor
+		}
+	}
+	for _, p := range prs {
+		resp.PullRequests = append(resp.PullRequests, *toPRResponse(p))
+	}
+
+	s.logger.Info(ctx, "ListPRs completed", zap.Int("prs_count", len(resp.PullRequests)), zap.Bool("has_more", resp.NextCursor != nil))
+	return resp, nil
+}
+
+func (s *PRService) parseListFilter(ctx context.Context, req *pr.ListPRsRequest) (entity.PRListFilter, error) {
+	filter := entity.PRListFilter{
+		AuthorID:   req.AuthorID,
+		ReviewerID: req.ReviewerID,
+		TeamName:   req.TeamName,
+		SortBy:     entity.SortByCreatedAt,
+		Desc:       true,
+		Limit:      defaultListLimit,
+	}
+
+	for _, raw := range req.Statuses {
+		status := entity.PRStatus(strings.ToUpper(raw))
+		switch status {
+		case entity.StatusDraft, entity.StatusOpen, entity.StatusMerged, entity.StatusClosed:
+			filter.Statuses = append(filter.Statuses, status)
+		default:
+			s.logger.Warn(ctx, "Invalid status filter", zap.String("status", raw))
+			return entity.PRListFilter{}, dto.ErrInvalidListQuery
+		}
+	}
+
+	for _, id := range []string{req.AuthorID, req.ReviewerID} {
+		if id == "" {
+			continue
+		}
+		if _, err := uuid.Parse(id); err != nil {
+			s.logger.Warn(ctx, "Invalid user id filter", zap.String("user_id", id))
+			return entity.PRListFilter{}, dto.ErrInvalidListQuery
+		}
+	}
+
+	switch entity.PRSortField(req.SortBy) {
+	case "", entity.SortByCreatedAt:
+	case entity.SortByMergedAt:
+		filter.SortBy = entity.SortByMergedAt
+	default:
+		s.logger.Warn(ctx, "Invalid sort field", zap.String("sort_by", req.SortBy))
+		return entity.PRListFilter{}, dto.ErrInvalidListQuery
+	}
+
+	switch strings.ToLower(req.Order) {
+	case "", "desc":
+	case "asc":
+		filter.Desc = false
+	default:
+		s.logger.Warn(ctx, "Invalid sort order", zap.String("order", req.Order))
+		return entity.PRListFilter{}, dto.ErrInvalidListQuery
+	}
+
+	if req.Limit != "" {
+		limit, err := strconv.Atoi(req.Limit)
+		if err != nil || limit < 1 || limit > maxListLimit {
+			s.logger.Warn(ctx, "Invalid list limit", zap.String("limit", req.Limit))
+			return entity.PRListFilter{}, dto.ErrInvalidListQuery
+		}
+		filter.Limit = limit
+	}
+
+	var err error
+	if filter.CreatedFrom, filter.CreatedTo, err = parseRange(req.CreatedFrom, req.CreatedTo); err != nil {
+		s.logger.Warn(ctx, "Invalid created_at range",
+			zap.String("created_from", req.CreatedFrom), zap.String("created_to", req.CreatedTo))
+		return entity.PRListFilter{}, err
+	}
+	if filter.MergedFrom, filter.MergedTo, err = parseRange(req.MergedFrom, req.MergedTo); err != nil {
+		s.logger.Warn(ctx, "Invalid merged_at range",
+			zap.String("merged_from", req.MergedFrom), zap.String("merged_to", req.MergedTo))
+		return entity.PRListFilter{}, err
+	}
+
+	if req.Cursor != "" {
+		cursor, err := decodeCursor(req.Cursor)
+		if err != nil || cursor.SortBy != filter.SortBy || cursor.Desc != filter.Desc || cursor.Filters != filtersHash(filter) {
+			s.logger.Warn(ctx, "Invalid list cursor", zap.String("cursor", req.Cursor))
+			return entity.PRListFilter{}, dto.ErrInvalidListQuery
+		}
+		filter.After = &entity.PRListKey{At: cursor.At, PullRequestID: cursor.ID}
+	}
+
+	return filter, nil
+}
+
+// parseRange разбирает полуинтервал [from, to) в RFC3339; пустая граница —
+// открытая.
+func parseRange(rawFrom, rawTo string) (*time.Time, *time.Time, error) {
+	from, err := parseTime(rawFrom)
+	if err != nil {
+		return nil, nil, dto.ErrInvalidTimeRange
+	}
+	to, err := parseTime(rawTo)
+	if err != nil {
+		return nil, nil, dto.ErrInvalidTimeRange
+	}
+	if from != nil && to != nil && !from.Before(*to) {
+		return nil, nil, dto.ErrInvalidTimeRange
+	}
+	return from, to, nil
+}
+
+func parseTime(raw string) (*time.Time, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return nil, err
+	}
+	t = t.UTC()
+	return &t, nil
+}
+
+// filtersHash возвращает хэш фильтров списка без учёта сортировки, лимита и
+// позиции курсора.
+func filtersHash(filter entity.PRListFilter) string {
+	statuses := make([]string, 0, len(filter.Statuses))
+	for _, status := range filter.Statuses {
+		statuses = append(statuses, string(status))
+	}
+	slices.Sort(statuses)
+
+	h := sha256.New()
+	for _, part := range []string{
+		strings.Join(statuses, ","),
+		filter.AuthorID,
+		filter.ReviewerID,
+		filter.TeamName,
+		formatBound(filter.CreatedFrom),
+		formatBound(filter.CreatedTo),
+		formatBound(filter.MergedFrom),
+		formatBound(filter.MergedTo),
+	} {
+		h.Write([]byte(part))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil)[:8])
+}
+
+func formatBound(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339Nano)
+}
+
+func encodeCursor(c listCursor) (string, error) {
+	raw, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+func decodeCursor(s string) (listCursor, error) {
+	var c listCursor
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return c, err
+	}
+	if err := json.Unmarshal(raw, &c); err != nil {
+		return c, err
+	}
+	if c.At.IsZero() {
+		return c, dto.ErrInvalidListQuery
+	}
+	_, err = uuid.Parse(c.ID)
+	return c, err
+}
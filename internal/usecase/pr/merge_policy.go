@@ -0,0 +1,150 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"pr_reviewer_assignment_service/internal/dto"
+	"pr_reviewer_assignment_service/internal/entity"
+
+	"go.uber.org/zap"
+)
+
+const (
+	RuleMinApprovals         = "min_approvals"
+	RuleNoChangesRequested   = "no_changes_requested"
+	RuleNonAuthorApproval    = "non_author_approval"
+	RuleRequiredReviewerTeam = "required_reviewer_team"
+)
+
+// mergeCheck готовит проверку PR политикой его команды. Если у команды нет
+// своей политики, действует политика по умолчанию с REQUIRED_APPROVALS.
+// Саму проверку вызывает Merge репозитория по вердиктам, прочитанным под
+// блокировкой PR, поэтому вердикт, отправленный одновременно с merge, не
+// пропускается.
+func (s *PRService) mergeCheck(ctx context.Context, prEntity *entity.PullRequest) (func([]entity.Review) error, error) {
+	policy, err := s.mergePolicy(ctx, prEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	requiredTeamMembers := map[string]bool{}
+	if policy.RequiredReviewerTeam != nil {
+		team, err := s.teamRepo.GetTeamByName(ctx, *policy.RequiredReviewerTeam)
+		if err != nil && !errors.Is(err, dto.ErrNotFound) {
+			return nil, err
+		}
+		if team != nil {
+			for _, member := range team.Members {
+				requiredTeamMembers[member.UserID] = true
+			}
+		}
+	}
+
+	return func(reviews []entity.Review) error {
+		checked := *prEntity
+		checked.Reviews = reviews
+
+		violations := evaluateMergePolicy(policy, &checked, requiredTeamMembers)
+		if len(violations) > 0 {
+			s.logger.Warn(ctx, "Merge blocked by policy",
+				zap.String("pull_request_id", prEntity.PullRequestID),
+				zap.String("team_name", policy.TeamName),
+				zap.Int("violations", len(violations)),
+			)
+			return &dto.MergeBlockedError{Violations: violations}
+		}
+
+		prEntity.Reviews = reviews
+		return nil
+	}, nil
+}
+
+// mergePolicy возвращает политику команды PR, а для PR без команды —
+// основной команды автора. Если команду определить нельзя, действует
+// политика по умолчанию.
+func (s *PRService) mergePolicy(ctx context.Context, prEntity *entity.PullRequest) (*entity.MergePolicy, error) {
+	defaultPolicy := &entity.MergePolicy{MinApprovals: s.cfg.RequiredApprovals}
+
+	teamName := prEntity.TeamName
+	if teamName == "" && prEntity.AuthorID != "" {
+		author, err := s.userRepo.GetByID(ctx, prEntity.AuthorID)
+		if err != nil && !errors.Is(err, dto.ErrNotFound) {
+			return nil, err
+		}
+		if author != nil {
+			teamName = author.TeamName
+		}
+	}
+	if teamName == "" {
+		return defaultPolicy, nil
+	}
+
+	policy, err := s.teamRepo.GetMergePolicy(ctx, teamName)
+	if errors.Is(err, dto.ErrNotFound) {
+		defaultPolicy.TeamName = teamName
+		return defaultPolicy, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+func evaluateMergePolicy(policy *entity.MergePolicy, prEntity *entity.PullRequest, requiredTeamMembers map[string]bool) []dto.PolicyViolation {
+	violations := []dto.PolicyViolation{}
+
+	approvals := 0
+	// Автор не бывает ревьювером своего PR, но вердикт проверяется явно:
+	// правило требует хотя бы одного одобрения не от автора даже при
+	// min_approvals = 0.
+	nonAuthorApprovals := 0
+	requiredTeamApproved := false
+	changesRequestedBy := []string{}
+	for _, review := range prEntity.Reviews {
+		switch review.Verdict {
+		case entity.VerdictApproved:
+			approvals++
+			if review.UserID != prEntity.AuthorID {
+				nonAuthorApprovals++
+			}
+			if requiredTeamMembers[review.UserID] {
+				requiredTeamApproved = true
+			}
+		case entity.VerdictChangesRequested:
+			changesRequestedBy = append(changesRequestedBy, review.UserID)
+		}
+	}
+
+	if approvals < policy.MinApprovals {
+		violations = append(violations, dto.PolicyViolation{
+			Rule:    RuleMinApprovals,
+			Message: fmt.Sprintf("%d approvals required, got %d", policy.MinApprovals, approvals),
+		})
+	}
+
+	if policy.BlockOnChangesRequested && len(changesRequestedBy) > 0 {
+		violations = append(violations, dto.PolicyViolation{
+			Rule:    RuleNoChangesRequested,
+			Message: fmt.Sprintf("changes requested by %v", changesRequestedBy),
+		})
+	}
+
+	if policy.ForbidAuthorOnlyApproval && nonAuthorApprovals == 0 {
+		violations = append(violations, dto.PolicyViolation{
+			Rule:    RuleNonAuthorApproval,
+			Message: "approval from someone other than the author required",
+		})
+	}
+
+	if policy.RequiredReviewerTeam != nil && !requiredTeamApproved {
+		violations = append(violations, dto.PolicyViolation{
+			Rule:    RuleRequiredReviewerTeam,
+			Message: fmt.Sprintf("approval from team %q required", *policy.RequiredReviewerTeam),
+		})
+	}
+
+	return violations
+}
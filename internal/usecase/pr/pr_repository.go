@@ -3,13 +3,19 @@ package usecase
 import (
 	"context"
 	"pr_reviewer_assignment_service/internal/entity"
+	"time"
 )
 
 // internal/usecase/pr/pr_service.go
 type PRRepository interface {
 	GetByID(ctx context.Context, prID string) (*entity.PullRequest, error)
 	Create(ctx context.Context, pr *entity.PullRequest) error
-	Merge(ctx context.Context, prID string, pr *entity.PullRequest) error
+	Merge(ctx context.Context, prID string, pr *entity.PullRequest, check func([]entity.Review) error) error
+	UpdateStatus(ctx context.Context, pr *entity.PullRequest, from entity.PRStatus) error
+	SubmitVerdict(ctx context.Context, prID, userID string, verdict entity.ReviewVerdict, at time.Time) error
 	ReassignReviewer(ctx context.Context, prID, oldUserID, newUserID string) (*entity.PullRequest, error)
 	GetByReviewer(ctx context.Context, userID string) ([]*entity.PullRequest, error)
+	GetOpenByReviewers(ctx context.Context, userIDs []string) ([]*entity.PullRequest, error)
+	CountOpenReviews(ctx context.Context, userIDs []string) (map[string]int, error)
+	List(ctx context.Context, filter entity.PRListFilter) ([]*entity.PullRequest, error)
 }
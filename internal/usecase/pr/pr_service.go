@@ -2,6 +2,8 @@ package usecase
 
 import (
 	"context"
+	"errors"
+	"slices"
 	"time"
 
 	"pr_reviewer_assignment_service/internal/dto"
@@ -14,23 +16,34 @@ import (
 	"go.uber.org/zap"
 )
 
+type Config struct {
+	MaxReviewers      int
+	RequiredApprovals int
+}
+
 type PRService struct {
-	repo     PRRepository
-	teamRepo usecaseTeam.TeamRepository
-	userRepo usecaseUser.UserRepository
-	logger   logger.Logger
+	repo             PRRepository
+	teamRepo         usecaseTeam.TeamRepository
+	userRepo         usecaseUser.UserRepository
+	selector         ReviewerSelector
+	reassignSelector ReviewerSelector
+	cfg              Config
+	logger           logger.Logger
 }
 
 func (s *PRService) Logger() logger.Logger {
 	return s.logger
 }
 
-func NewPRService(repo PRRepository, teamRepo usecaseTeam.TeamRepository, userRepo usecaseUser.UserRepository, logger logger.Logger) *PRService {
+func NewPRService(repo PRRepository, teamRepo usecaseTeam.TeamRepository, userRepo usecaseUser.UserRepository, selector ReviewerSelector, cfg Config, logger logger.Logger) *PRService {
 	return &PRService{
-		repo:     repo,
-		teamRepo: teamRepo,
-		userRepo: userRepo,
-		logger:   logger,
+		repo:             repo,
+		teamRepo:         teamRepo,
+		userRepo:         userRepo,
+		selector:         selector,
+		reassignSelector: NewLeastLoadedSelector(repo),
+		cfg:              cfg,
+		logger:           logger,
 	}
 }
 
@@ -38,6 +51,7 @@ func (s *PRService) CreatePR(ctx context.Context, req *pr.CreatePRRequest) (*pr.
 	s.logger.Info(ctx, "CreatePR called",
 		zap.String("pull_request_id", req.PullRequestID),
 		zap.String("author_id", req.AuthorID),
+		zap.Bool("draft", req.Draft),
 	)
 
 	existing, _ := s.repo.GetByID(ctx, req.PullRequestID)
@@ -52,32 +66,45 @@ func (s *PRService) CreatePR(ctx context.Context, req *pr.CreatePRRequest) (*pr.
 		return nil, dto.ErrNotFound
 	}
 
-	team, err := s.teamRepo.GetTeamByName(ctx, author.TeamName)
-	if err != nil {
-		s.logger.Error(ctx, "Team not found", zap.String("team_name", author.TeamName), zap.Error(err))
-		return nil, dto.ErrNotFound
+	teamName := author.TeamName
+	if req.TeamName != "" {
+		teamName = req.TeamName
 	}
 
-	candidates := []string{}
-	for _, member := range team.Members {
-		if member.IsActive && member.UserID != author.UserID {
-			candidates = append(candidates, member.UserID)
-		}
-	}
+	changes := changeSet{repository: req.Repository, files: normalizePaths(req.ChangedFiles)}
 
-	if len(candidates) > 2 {
-		candidates = candidates[:2]
+	status := entity.StatusOpen
+	selection := &reviewerSelection{reviewers: []string{}, fallback: []string{}}
+	if req.Draft {
+		status = entity.StatusDraft
+		if req.TeamName != "" {
+			if _, err := s.authorTeam(ctx, author, teamName); err != nil {
+				return nil, err
+			}
+		}
+	} else {
+		team, err := s.authorTeam(ctx, author, teamName)
+		if err != nil {
+			return nil, err
+		}
+		selection, err = s.selectReviewers(ctx, author, team, req.ReviewersCount, changes)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	s.logger.Info(ctx, "Assigning reviewers", zap.Strings("reviewers", candidates))
-
 	now := time.Now()
 	prEntity := &entity.PullRequest{
 		PullRequestID:     req.PullRequestID,
 		Name:              req.PullRequestName,
 		AuthorID:          req.AuthorID,
-		Status:            entity.StatusOpen,
-		AssignedReviewers: candidates,
+		TeamName:          teamName,
+		Status:            status,
+		AssignedReviewers: selection.reviewers,
+		FallbackReviewers: selection.fallback,
+		UnderStaffed:      selection.underStaffed,
+		Repository:        changes.repository,
+		ChangedFiles:      changes.files,
 		CreatedAt:         &now,
 	}
 
@@ -88,14 +115,167 @@ func (s *PRService) CreatePR(ctx context.Context, req *pr.CreatePRRequest) (*pr.
 
 	s.logger.Info(ctx, "PR created successfully", zap.String("pull_request_id", prEntity.PullRequestID))
 
-	return &pr.PRResponse{
-		PullRequestID:     prEntity.PullRequestID,
-		PullRequestName:   prEntity.Name,
-		AuthorID:          prEntity.AuthorID,
-		Status:            string(prEntity.Status),
-		AssignedReviewers: prEntity.AssignedReviewers,
-		CreatedAt:         nil,
-	}, nil
+	return toPRResponse(prEntity), nil
+}
+
+// authorTeam загружает команду, из которой подбираются ревьюверы PR.
+// Автор должен в ней состоять.
+func (s *PRService) authorTeam(ctx context.Context, author *entity.User, teamName string) (*entity.Team, error) {
+	team, err := s.teamRepo.GetTeamByName(ctx, teamName)
+	if err != nil {
+		s.logger.Error(ctx, "Team not found", zap.String("team_name", teamName), zap.Error(err))
+		return nil, dto.ErrNotFound
+	}
+
+	if teamName != author.TeamName && !slices.ContainsFunc(team.Members, func(m entity.User) bool { return m.UserID == author.UserID }) {
+		s.logger.Warn(ctx, "Author is not a member of the team", zap.String("author_id", author.UserID), zap.String("team_name", teamName))
+		return nil, dto.ErrNotTeamMember
+	}
+
+	return team, nil
+}
+
+// reviewerSelection — результат подбора ревьюверов. underStaffed означает,
+// что ревьюверов меньше нужного из-за лимитов открытых ревью.
+type reviewerSelection struct {
+	reviewers    []string
+	fallback     []string
+	underStaffed bool
+}
+
+// selectReviewers подбирает ревьюверов для PR автора из команды team. Если
+// в ней не хватает кандидатов, недостающие места заполняются из резервных
+// команд. Кандидаты, упёршиеся в лимит открытых ревью, не назначаются.
+// Владельцы изменённых файлов changes выбираются в первую очередь.
+func (s *PRService) selectReviewers(ctx context.Context, author *entity.User, team *entity.Team, requested *int, changes changeSet) (*reviewerSelection, error) {
+	count, err := s.reviewersCount(team, requested)
+	if err != nil {
+		s.logger.Warn(ctx, "Invalid reviewers count", zap.String("author_id", author.UserID), zap.Error(err))
+		return nil, err
+	}
+
+	changes, err = s.withCodeowners(ctx, changes)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates, capped, err := s.withinCapacity(ctx, team, eligibleCandidates(team, author.UserID, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	reviewers, err := s.pick(ctx, team.TeamName, candidates, count, changes)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to select reviewers", zap.String("team_name", team.TeamName), zap.Error(err))
+		return nil, err
+	}
+
+	fallback := []string{}
+	if len(reviewers) < count {
+		var fallbackCapped bool
+		fallback, fallbackCapped, err = s.fallbackReviewers(ctx, team.TeamName, author.UserID, reviewers, count-len(reviewers), changes)
+		if err != nil {
+			return nil, err
+		}
+		reviewers = append(reviewers, fallback...)
+		capped = capped || fallbackCapped
+	}
+
+	if requested != nil && len(reviewers) < *requested {
+		s.logger.Warn(ctx, "Not enough reviewers available",
+			zap.String("author_id", author.UserID),
+			zap.Int("requested", *requested),
+			zap.Int("available", len(reviewers)),
+		)
+		return nil, dto.ErrNotEnoughReviewers
+	}
+
+	underStaffed := capped && len(reviewers) < count
+	if underStaffed {
+		s.logger.Warn(ctx, "PR is under-staffed: reviewers at capacity",
+			zap.String("team_name", team.TeamName),
+			zap.Int("wanted", count),
+			zap.Int("assigned", len(reviewers)),
+		)
+	}
+
+	s.logger.Info(ctx, "Assigning reviewers", zap.Strings("reviewers", reviewers), zap.Strings("fallback_reviewers", fallback))
+	return &reviewerSelection{reviewers: reviewers, fallback: fallback, underStaffed: underStaffed}, nil
+}
+
+// fallbackReviewers добирает до need ревьюверов из резервных команд teamName
+// в порядке их приоритета. Второе значение сообщает, что кто-то из
+// кандидатов был отброшен из-за лимита открытых ревью.
+func (s *PRService) fallbackReviewers(ctx context.Context, teamName, authorID string, chosen []string, need int, changes changeSet) ([]string, bool, error) {
+	fallbackTeams, err := s.teamRepo.GetFallbackTeams(ctx, teamName)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get fallback teams", zap.String("team_name", teamName), zap.Error(err))
+		return nil, false, err
+	}
+
+	result := []string{}
+	capped := false
+	for _, name := range fallbackTeams {
+		if need == 0 {
+			break
+		}
+
+		team, err := s.teamRepo.GetTeamByName(ctx, name)
+		if errors.Is(err, dto.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, false, err
+		}
+
+		exclude := append(slices.Clone(chosen), result...)
+		candidates, teamCapped, err := s.withinCapacity(ctx, team, eligibleCandidates(team, authorID, exclude))
+		if err != nil {
+			return nil, false, err
+		}
+		capped = capped || teamCapped
+
+		selected, err := s.pick(ctx, team.TeamName, candidates, need, changes)
+		if err != nil {
+			s.logger.Error(ctx, "Failed to select fallback reviewers", zap.String("team_name", name), zap.Error(err))
+			return nil, false, err
+		}
+
+		result = append(result, selected...)
+		need -= len(selected)
+	}
+
+	return result, capped, nil
+}
+
+// eligibleCandidates возвращает активных и не отсутствующих участников
+// команды, кроме автора и пользователей из exclude.
+func eligibleCandidates(team *entity.Team, authorID string, exclude []string) []string {
+	candidates := []string{}
+	for _, member := range team.Members {
+		if !member.IsActive || member.Absent || member.UserID == authorID || slices.Contains(exclude, member.UserID) {
+			continue
+		}
+		candidates = append(candidates, member.UserID)
+	}
+	return candidates
+}
+
+// reviewersCount определяет число ревьюверов: значение из запроса важнее
+// настройки команды, а та, в свою очередь, важнее глобального MAX_REVIEWERS.
+func (s *PRService) reviewersCount(team *entity.Team, requested *int) (int, error) {
+	if requested != nil {
+		if *requested < 0 {
+			return 0, dto.ErrInvalidReviewersCount
+		}
+		return *requested, nil
+	}
+
+	if team.MaxReviewers != nil {
+		return *team.MaxReviewers, nil
+	}
+
+	return s.cfg.MaxReviewers, nil
 }
 
 func (s *PRService) MergePR(ctx context.Context, req *pr.MergeRequest) (*pr.PRResponse, error) {
@@ -108,40 +288,93 @@ func (s *PRService) MergePR(ctx context.Context, req *pr.MergeRequest) (*pr.PRRe
 	}
 
 	if prEntity.Status == entity.StatusMerged {
-		s.logger.Warn(ctx, "PR already merged", zap.String("pull_request_id", req.PullRequestID))
-		return nil, dto.ErrPRMerged
+		s.logger.Info(ctx, "PR already merged, returning current state", zap.String("pull_request_id", req.PullRequestID))
+		return toPRResponse(prEntity), nil
+	}
+
+	if !canTransition(prEntity.Status, entity.StatusMerged) {
+		s.logger.Warn(ctx, "PR cannot be merged",
+			zap.String("pull_request_id", req.PullRequestID),
+			zap.String("status", string(prEntity.Status)),
+		)
+		return nil, dto.ErrInvalidTransition
+	}
+
+	check, err := s.mergeCheck(ctx, prEntity)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to resolve merge policy", zap.String("pull_request_id", req.PullRequestID), zap.Error(err))
+		return nil, err
 	}
 
 	now := time.Now()
 	prEntity.Status = entity.StatusMerged
 	prEntity.MergedAt = &now
 
-	if err := s.repo.Merge(ctx, req.PullRequestID, prEntity); err != nil {
+	if err := s.repo.Merge(ctx, req.PullRequestID, prEntity, check); err != nil {
+		if errors.Is(err, dto.ErrPRMerged) {
+			s.logger.Info(ctx, "PR merged concurrently, returning current state", zap.String("pull_request_id", req.PullRequestID))
+			return s.currentState(ctx, req.PullRequestID)
+		}
 		s.logger.Error(ctx, "Failed to merge PR", zap.String("pull_request_id", req.PullRequestID), zap.Error(err))
 		return nil, err
 	}
 
 	s.logger.Info(ctx, "PR merged successfully", zap.String("pull_request_id", prEntity.PullRequestID))
 
-	return &pr.PRResponse{
-		PullRequestID:     prEntity.PullRequestID,
-		PullRequestName:   prEntity.Name,
-		AuthorID:          prEntity.AuthorID,
-		Status:            string(prEntity.Status),
-		AssignedReviewers: prEntity.AssignedReviewers,
-		MergedAt:          nil,
-	}, nil
+	return toPRResponse(prEntity), nil
+}
+
+func (s *PRService) currentState(ctx context.Context, prID string) (*pr.PRResponse, error) {
+	prEntity, err := s.repo.GetByID(ctx, prID)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to fetch PR", zap.String("pull_request_id", prID), zap.Error(err))
+		return nil, err
+	}
+
+	return toPRResponse(prEntity), nil
 }
 
 func (s *PRService) ReassignReviewer(ctx context.Context, req *pr.ReassignRequest) (*pr.PRResponse, string, error) {
 	s.logger.Info(ctx, "ReassignReviewer called",
 		zap.String("pull_request_id", req.PullRequestID),
 		zap.String("old_user_id", req.OldUserID),
+		zap.String("new_user_id", req.NewUserID),
 	)
 
-	prEntity, err := s.repo.ReassignReviewer(ctx, req.PullRequestID, req.OldUserID, "")
+	current, err := s.repo.GetByID(ctx, req.PullRequestID)
 	if err != nil {
-		s.logger.Error(ctx, "Failed to reassign reviewer",
+		s.logger.Error(ctx, "PR not found", zap.String("pull_request_id", req.PullRequestID), zap.Error(err))
+		return nil, "", err
+	}
+
+	if current.Status == entity.StatusMerged {
+		s.logger.Warn(ctx, "Cannot reassign reviewer on merged PR", zap.String("pull_request_id", req.PullRequestID))
+		return nil, "", dto.ErrPRMerged
+	}
+	if current.Status != entity.StatusOpen {
+		s.logger.Warn(ctx, "Cannot reassign reviewer on PR that is not open",
+			zap.String("pull_request_id", req.PullRequestID),
+			zap.String("status", string(current.Status)),
+		)
+		return nil, "", dto.ErrPRNotOpen
+	}
+
+	if !slices.Contains(current.AssignedReviewers, req.OldUserID) {
+		s.logger.Warn(ctx, "Old reviewer not assigned to PR",
+			zap.String("pull_request_id", req.PullRequestID),
+			zap.String("old_user_id", req.OldUserID),
+		)
+		return nil, "", dto.ErrNotAssigned
+	}
+
+	newUserID := req.NewUserID
+	if newUserID != "" {
+		err = s.validateReplacement(ctx, current, newUserID)
+	} else {
+		newUserID, err = s.pickReplacement(ctx, current, s.prTeam(ctx, current))
+	}
+	if err != nil {
+		s.logger.Warn(ctx, "Failed to pick replacement reviewer",
 			zap.String("pull_request_id", req.PullRequestID),
 			zap.String("old_user_id", req.OldUserID),
 			zap.Error(err),
@@ -149,25 +382,151 @@ func (s *PRService) ReassignReviewer(ctx context.Context, req *pr.ReassignReques
 		return nil, "", err
 	}
 
-	var replacedBy string
-	for _, r := range prEntity.AssignedReviewers {
-		if r != req.OldUserID {
-			replacedBy = r
-			break
-		}
+	prEntity, err := s.repo.ReassignReviewer(ctx, req.PullRequestID, req.OldUserID, newUserID)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to reassign reviewer",
+			zap.String("pull_request_id", req.PullRequestID),
+			zap.String("old_user_id", req.OldUserID),
+			zap.Error(err),
+		)
+		return nil, "", err
 	}
 
 	s.logger.Info(ctx, "Reviewer reassigned",
 		zap.String("pull_request_id", req.PullRequestID),
 		zap.String("old_user_id", req.OldUserID),
-		zap.String("new_user_id", replacedBy),
+		zap.String("new_user_id", newUserID),
 	)
 
+	return toPRResponse(prEntity), newUserID, nil
+}
+
+// prTeam возвращает команду PR, а для PR без команды — основную команду
+// автора.
+func (s *PRService) prTeam(ctx context.Context, prEntity *entity.PullRequest) string {
+	if prEntity.TeamName != "" {
+		return prEntity.TeamName
+	}
+
+	author, err := s.userRepo.GetByID(ctx, prEntity.AuthorID)
+	if err != nil {
+		s.logger.Warn(ctx, "Author not found", zap.String("author_id", prEntity.AuthorID), zap.Error(err))
+		return ""
+	}
+	return author.TeamName
+}
+
+// pickReplacement выбирает замену из доступных участников команды teamName,
+// исключая автора, уже назначенных ревьюверов и упёршихся в лимит открытых
+// ревью, с наименьшей загрузкой.
+func (s *PRService) pickReplacement(ctx context.Context, prEntity *entity.PullRequest, teamName string) (string, error) {
+	if teamName == "" {
+		return "", dto.ErrNoCandidate
+	}
+
+	team, err := s.teamRepo.GetTeamByName(ctx, teamName)
+	if err != nil {
+		return "", err
+	}
+
+	candidates, _, err := s.withinCapacity(ctx, team, eligibleCandidates(team, prEntity.AuthorID, prEntity.AssignedReviewers))
+	if err != nil {
+		return "", err
+	}
+
+	selected, err := s.reassignSelector.Select(ctx, team.TeamName, candidates, 1)
+	if err != nil {
+		return "", err
+	}
+	if len(selected) == 0 {
+		return "", dto.ErrNoCandidate
+	}
+
+	return selected[0], nil
+}
+
+// validateReplacement проверяет явно указанного ревьювера: он должен быть
+// активен и не отсутствовать, не быть автором или уже назначенным, не упереться в лимит
+// открытых ревью и иметь активное членство в команде PR.
+func (s *PRService) validateReplacement(ctx context.Context, prEntity *entity.PullRequest, newUserID string) error {
+	if newUserID == prEntity.AuthorID {
+		return dto.ErrReviewerIsAuthor
+	}
+	if slices.Contains(prEntity.AssignedReviewers, newUserID) {
+		return dto.ErrAlreadyAssigned
+	}
+
+	target, err := s.userRepo.GetByID(ctx, newUserID)
+	if err != nil {
+		return err
+	}
+	if !target.IsActive {
+		return dto.ErrReviewerInactive
+	}
+	if target.Absent {
+		return dto.ErrReviewerAbsent
+	}
+
+	teamName := s.prTeam(ctx, prEntity)
+	if teamName == "" {
+		return dto.ErrTeamNotAllowed
+	}
+	team, err := s.teamRepo.GetTeamByName(ctx, teamName)
+	if errors.Is(err, dto.ErrNotFound) {
+		return dto.ErrTeamNotAllowed
+	}
+	if err != nil {
+		return err
+	}
+	idx := slices.IndexFunc(team.Members, func(m entity.User) bool { return m.UserID == newUserID })
+	if idx < 0 {
+		return dto.ErrTeamNotAllowed
+	}
+	if !team.Members[idx].IsActive {
+		return dto.ErrReviewerInactive
+	}
+
+	return s.checkCapacity(ctx, target, team)
+}
+
+func toPRResponse(p *entity.PullRequest) *pr.PRResponse {
+	reviewers := p.AssignedReviewers
+	if reviewers == nil {
+		reviewers = []string{}
+	}
+
+	var reviews []pr.ReviewDTO
+	for _, review := range p.Reviews {
+		reviews = append(reviews, pr.ReviewDTO{
+			UserID:     review.UserID,
+			Verdict:    string(review.Verdict),
+			AssignedAt: formatTime(review.AssignedAt),
+			VerdictAt:  formatTime(review.VerdictAt),
+			Fallback:   review.Fallback,
+		})
+	}
+
 	return &pr.PRResponse{
-		PullRequestID:     prEntity.PullRequestID,
-		PullRequestName:   prEntity.Name,
-		AuthorID:          prEntity.AuthorID,
-		Status:            string(prEntity.Status),
-		AssignedReviewers: prEntity.AssignedReviewers,
-	}, replacedBy, nil
+		PullRequestID:     p.PullRequestID,
+		PullRequestName:   p.Name,
+		AuthorID:          p.AuthorID,
+		TeamName:          p.TeamName,
+		Status:            string(p.Status),
+		AssignedReviewers: reviewers,
+		FallbackReviewers: p.FallbackReviewers,
+		Reviews:           reviews,
+		UnderStaffed:      p.UnderStaffed,
+		Repository:        p.Repository,
+		ChangedFiles:      p.ChangedFiles,
+		CreatedAt:         formatTime(p.CreatedAt),
+		MergedAt:          formatTime(p.MergedAt),
+	}
+}
+
+func formatTime(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	s := t.UTC().Format(time.RFC3339)
+	return &s
 }
@@ -0,0 +1,166 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+
+	"pr_reviewer_assignment_service/internal/dto"
+	"pr_reviewer_assignment_service/internal/entity"
+
+	"go.uber.org/zap"
+)
+
+// PlanReassignments подбирает замену каждому из userIDs во всех открытых PR,
+// где они ревьюверы, ничего не записывая. Замена берётся из команды PR среди
+// доступных участников с наименьшей загрузкой, с учётом лимитов открытых
+// ревью и уже запланированных назначений. Если кандидата нет, NewUserID
+// пуст. Загрузка и составы команд читаются одним набором запросов, поэтому
+// план для сотен пользователей строится за несколько обращений к БД.
+func (s *PRService) PlanReassignments(ctx context.Context, userIDs []string) ([]entity.Reassignment, error) {
+	s.logger.Info(ctx, "PlanReassignments called", zap.Int("users_count", len(userIDs)))
+
+	return s.planReassignments(ctx, userIDs, planScope{})
+}
+
+// PlanTeamReassignments строит план, как PlanReassignments, но только для
+// открытых PR команды teamName.
+func (s *PRService) PlanTeamReassignments(ctx context.Context, userIDs []string, teamName string) ([]entity.Reassignment, error) {
+	s.logger.Info(ctx, "PlanTeamReassignments called", zap.Int("users_count", len(userIDs)), zap.String("team_name", teamName))
+
+	return s.planReassignments(ctx, userIDs, planScope{team: teamName})
+}
+
+// PlanArchiveReassignments строит план, как PlanReassignments, для
+// участников архивируемой команды teamName: в PR самой этой команды замена
+// не подбирается. У staying, остающихся активными в других командах, в план
+// попадают только PR архивируемой команды.
+func (s *PRService) PlanArchiveReassignments(ctx context.Context, userIDs []string, teamName string, staying []string) ([]entity.Reassignment, error) {
+	s.logger.Info(ctx, "PlanArchiveReassignments called",
+		zap.Int("users_count", len(userIDs)),
+		zap.Int("staying_count", len(staying)),
+		zap.String("team_name", teamName),
+	)
+
+	return s.planReassignments(ctx, userIDs, planScope{archived: teamName, staying: staying})
+}
+
+// planScope ограничивает план: team — только PR этой команды, archived —
+// команда, из которой замены не берутся, staying — пользователи, чьи ревью
+// переносятся только из PR команды archived.
+type planScope struct {
+	team     string
+	archived string
+	staying  []string
+}
+
+func (s *PRService) planReassignments(ctx context.Context, userIDs []string, scope planScope) ([]entity.Reassignment, error) {
+	all, err := s.repo.GetOpenByReviewers(ctx, userIDs)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get open PRs of reviewers", zap.Error(err))
+		return nil, err
+	}
+
+	prs := all
+	if scope.team != "" {
+		prs = nil
+		for _, p := range all {
+			if p.TeamName == scope.team {
+				prs = append(prs, p)
+			}
+		}
+	}
+
+	staying := make(map[string]bool, len(scope.staying))
+	for _, userID := range scope.staying {
+		staying[userID] = true
+	}
+	leaving := make(map[string]bool, len(userIDs))
+	for _, userID := range userIDs {
+		if !staying[userID] {
+			leaving[userID] = true
+		}
+	}
+
+	teams := map[string]*entity.Team{}
+	candidates := map[string]bool{}
+	for _, p := range prs {
+		if _, ok := teams[p.TeamName]; ok || p.TeamName == "" {
+			continue
+		}
+		if p.TeamName == scope.archived {
+			teams[p.TeamName] = nil
+			continue
+		}
+		team, err := s.teamRepo.GetTeamByName(ctx, p.TeamName)
+		if errors.Is(err, dto.ErrNotFound) {
+			teams[p.TeamName] = nil
+			continue
+		}
+		if err != nil {
+			s.logger.Error(ctx, "Failed to get team", zap.String("team_name", p.TeamName), zap.Error(err))
+			return nil, err
+		}
+		teams[p.TeamName] = team
+		for _, member := range team.Members {
+			if !leaving[member.UserID] {
+				candidates[member.UserID] = true
+			}
+		}
+	}
+
+	loads := map[string]int{}
+	if len(candidates) > 0 {
+		loads, err = s.repo.CountOpenReviews(ctx, slices.Sorted(maps.Keys(candidates)))
+		if err != nil {
+			s.logger.Error(ctx, "Failed to count open reviews", zap.Error(err))
+			return nil, err
+		}
+	}
+
+	plan := []entity.Reassignment{}
+	for _, p := range prs {
+		assigned := slices.Clone(p.AssignedReviewers)
+		for _, reviewer := range p.AssignedReviewers {
+			if !leaving[reviewer] && !(staying[reviewer] && p.TeamName == scope.archived) {
+				continue
+			}
+
+			reassignment := entity.Reassignment{PullRequestID: p.PullRequestID, OldUserID: reviewer}
+			if team := teams[p.TeamName]; team != nil {
+				skip := func(userID string) bool {
+					return leaving[userID] || userID == p.AuthorID || slices.Contains(assigned, userID)
+				}
+				if newUserID := leastLoaded(team, loads, skip); newUserID != "" {
+					reassignment.NewUserID = newUserID
+					assigned = append(assigned, newUserID)
+					loads[newUserID]++
+				}
+			}
+			plan = append(plan, reassignment)
+		}
+	}
+
+	s.logger.Info(ctx, "Reassignments planned", zap.Int("prs_count", len(prs)), zap.Int("reassignments", len(plan)))
+	return plan, nil
+}
+
+// leastLoaded возвращает доступного участника команды с наименьшей
+// загрузкой, не упёршегося в лимит открытых ревью и не отсеянного skip; при
+// равной загрузке — с меньшим user_id.
+func leastLoaded(team *entity.Team, loads map[string]int, skip func(string) bool) string {
+	best := ""
+	for _, member := range team.Members {
+		if !member.IsActive || member.Absent || skip(member.UserID) {
+			continue
+		}
+		if limit := openReviewsLimit(&member, team); limit != nil && loads[member.UserID] >= *limit {
+			continue
+		}
+		if best == "" || loads[member.UserID] < loads[best] || (loads[member.UserID] == loads[best] && member.UserID < best) {
+			best = member.UserID
+		}
+	}
+	return best
+}
@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"pr_reviewer_assignment_service/internal/dto"
+	"pr_reviewer_assignment_service/internal/dto/pr"
+	"pr_reviewer_assignment_service/internal/entity"
+
+	"go.uber.org/zap"
+)
+
+var verdicts = []entity.ReviewVerdict{
+	entity.VerdictApproved,
+	entity.VerdictChangesRequested,
+	entity.VerdictCommented,
+}
+
+// SubmitReview сохраняет вердикт назначенного ревьювера. Повторный вызов
+// перезаписывает предыдущий вердикт.
+func (s *PRService) SubmitReview(ctx context.Context, req *pr.ReviewRequest) (*pr.PRResponse, error) {
+	s.logger.Info(ctx, "SubmitReview called",
+		zap.String("pull_request_id", req.PullRequestID),
+		zap.String("user_id", req.UserID),
+		zap.String("verdict", req.Verdict),
+	)
+
+	verdict := entity.ReviewVerdict(req.Verdict)
+	if !slices.Contains(verdicts, verdict) {
+		s.logger.Warn(ctx, "Invalid verdict", zap.String("verdict", req.Verdict))
+		return nil, dto.ErrInvalidVerdict
+	}
+
+	prEntity, err := s.repo.GetByID(ctx, req.PullRequestID)
+	if err != nil {
+		s.logger.Error(ctx, "PR not found", zap.String("pull_request_id", req.PullRequestID), zap.Error(err))
+		return nil, err
+	}
+
+	if prEntity.Status == entity.StatusMerged {
+		s.logger.Warn(ctx, "Cannot review merged PR", zap.String("pull_request_id", req.PullRequestID))
+		return nil, dto.ErrPRMerged
+	}
+	if prEntity.Status != entity.StatusOpen {
+		s.logger.Warn(ctx, "Cannot review PR that is not open",
+			zap.String("pull_request_id", req.PullRequestID),
+			zap.String("status", string(prEntity.Status)),
+		)
+		return nil, dto.ErrPRNotOpen
+	}
+	if !slices.Contains(prEntity.AssignedReviewers, req.UserID) {
+		s.logger.Warn(ctx, "Reviewer not assigned to PR",
+			zap.String("pull_request_id", req.PullRequestID),
+			zap.String("user_id", req.UserID),
+		)
+		return nil, dto.ErrNotAssigned
+	}
+
+	if err := s.repo.SubmitVerdict(ctx, req.PullRequestID, req.UserID, verdict, time.Now()); err != nil {
+		s.logger.Error(ctx, "Failed to submit verdict", zap.String("pull_request_id", req.PullRequestID), zap.Error(err))
+		return nil, err
+	}
+
+	s.logger.Info(ctx, "Review submitted",
+		zap.String("pull_request_id", req.PullRequestID),
+		zap.String("user_id", req.UserID),
+		zap.String("verdict", req.Verdict),
+	)
+
+	return s.currentState(ctx, req.PullRequestID)
+}
@@ -0,0 +1,178 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+)
+
+const (
+	StrategyRoundRobin  = "round_robin"
+	StrategyRandom      = "random"
+	StrategyLeastLoaded = "least_loaded"
+)
+
+// ReviewerSelector выбирает до count ревьюверов из списка кандидатов команды.
+// SelectRanked принимает кандидатов группами по убыванию приоритета: следующая
+// группа используется, только если предыдущих не хватило.
+type ReviewerSelector interface {
+	Select(ctx context.Context, teamName string, candidates []string, count int) ([]string, error)
+	SelectRanked(ctx context.Context, teamName string, ranked [][]string, count int) ([]string, error)
+}
+
+type ReviewLoadCounter interface {
+	CountOpenReviews(ctx context.Context, userIDs []string) (map[string]int, error)
+}
+
+func NewReviewerSelector(strategy string, loads ReviewLoadCounter) (ReviewerSelector, error) {
+	switch strategy {
+	case StrategyRoundRobin:
+		return NewRoundRobinSelector(), nil
+	case StrategyRandom:
+		return NewRandomSelector(), nil
+	case StrategyLeastLoaded:
+		return NewLeastLoadedSelector(loads), nil
+	default:
+		return nil, fmt.Errorf("unknown reviewer selection strategy: %q", strategy)
+	}
+}
+
+// RoundRobinSelector обходит кандидатов по возрастанию user_id, продолжая
+// с того места, где остановился в прошлый раз для этой команды.
+type RoundRobinSelector struct {
+	mu   sync.Mutex
+	last map[string]string
+}
+
+func NewRoundRobinSelector() *RoundRobinSelector {
+	return &RoundRobinSelector{last: make(map[string]string)}
+}
+
+func (s *RoundRobinSelector) Select(ctx context.Context, teamName string, candidates []string, count int) ([]string, error) {
+	return s.SelectRanked(ctx, teamName, [][]string{candidates}, count)
+}
+
+// SelectRanked обходит группы по порядку; внутри группы обход продолжается
+// после последнего выбранного кандидата, а курсор команды сдвигается один раз
+// за вызов.
+func (s *RoundRobinSelector) SelectRanked(ctx context.Context, teamName string, ranked [][]string, count int) ([]string, error) {
+	count = clampCount(count, rankedLen(ranked))
+	if count == 0 {
+		return []string{}, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	last := s.last[teamName]
+	selected := make([]string, 0, count)
+	for _, group := range ranked {
+		sorted := append([]string(nil), group...)
+		sort.Strings(sorted)
+
+		start := sort.SearchStrings(sorted, last)
+		if start < len(sorted) && sorted[start] == last {
+			start++
+		}
+
+		for i := 0; i < len(sorted) && len(selected) < count; i++ {
+			last = sorted[(start+i)%len(sorted)]
+			selected = append(selected, last)
+		}
+	}
+	s.last[teamName] = last
+
+	return selected, nil
+}
+
+type RandomSelector struct{}
+
+func NewRandomSelector() *RandomSelector {
+	return &RandomSelector{}
+}
+
+func (s *RandomSelector) Select(ctx context.Context, teamName string, candidates []string, count int) ([]string, error) {
+	return s.SelectRanked(ctx, teamName, [][]string{candidates}, count)
+}
+
+func (s *RandomSelector) SelectRanked(ctx context.Context, teamName string, ranked [][]string, count int) ([]string, error) {
+	count = clampCount(count, rankedLen(ranked))
+
+	selected := make([]string, 0, count)
+	for _, group := range ranked {
+		for _, i := range rand.Perm(len(group)) {
+			if len(selected) == count {
+				break
+			}
+			selected = append(selected, group[i])
+		}
+	}
+
+	return selected, nil
+}
+
+// LeastLoadedSelector отдаёт предпочтение кандидатам с наименьшим числом
+// открытых PR на ревью; при равной загрузке порядок случайный.
+type LeastLoadedSelector struct {
+	loads ReviewLoadCounter
+}
+
+func NewLeastLoadedSelector(loads ReviewLoadCounter) *LeastLoadedSelector {
+	return &LeastLoadedSelector{loads: loads}
+}
+
+func (s *LeastLoadedSelector) Select(ctx context.Context, teamName string, candidates []string, count int) ([]string, error) {
+	return s.SelectRanked(ctx, teamName, [][]string{candidates}, count)
+}
+
+func (s *LeastLoadedSelector) SelectRanked(ctx context.Context, teamName string, ranked [][]string, count int) ([]string, error) {
+	count = clampCount(count, rankedLen(ranked))
+	if count == 0 {
+		return []string{}, nil
+	}
+
+	var candidates []string
+	for _, group := range ranked {
+		candidates = append(candidates, group...)
+	}
+
+	loads, err := s.loads.CountOpenReviews(ctx, candidates)
+	if err != nil {
+		return nil, err
+	}
+
+	selected := make([]string, 0, count)
+	for _, group := range ranked {
+		shuffled := append([]string(nil), group...)
+		rand.Shuffle(len(shuffled), func(i, j int) {
+			shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+		})
+		sort.SliceStable(shuffled, func(i, j int) bool {
+			return loads[shuffled[i]] < loads[shuffled[j]]
+		})
+
+		selected = append(selected, shuffled[:min(len(shuffled), count-len(selected))]...)
+	}
+
+	return selected, nil
+}
+
+func clampCount(count, available int) int {
+	if count < 0 {
+		return 0
+	}
+	if count > available {
+		return available
+	}
+	return count
+}
+
+func rankedLen(ranked [][]string) int {
+	n := 0
+	for _, group := range ranked {
+		n += len(group)
+	}
+	return n
+}
@@ -0,0 +1,195 @@
+package usecase
+
+import (
+	"context"
+	"math"
+	"slices"
+	"time"
+
+	"pr_reviewer_assignment_service/internal/dto/stats"
+	"pr_reviewer_assignment_service/internal/entity"
+
+	"go.uber.org/zap"
+)
+
+// GetFairness возвращает показатели распределения открытых ревью между
+// активными участниками команд (или одной команды teamName).
+func (s *StatsService) GetFairness(ctx context.Context, teamName string) (*stats.FairnessResponse, error) {
+	s.logger.Info(ctx, "GetFairness called", zap.String("team_name", teamName))
+
+	if err := s.checkTeam(ctx, teamName); err != nil {
+		return nil, err
+	}
+
+	teams, err := s.teamFairness(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &stats.FairnessResponse{
+		Threshold: s.cfg.ImbalanceThreshold,
+		Teams:     make([]stats.TeamFairnessDTO, 0, len(teams)),
+	}
+	for _, t := range teams {
+		resp.Teams = append(resp.Teams, stats.TeamFairnessDTO{
+			TeamName:    t.TeamName,
+			Members:     t.Members,
+			OpenReviews: t.OpenReviews,
+			Gini:        t.Gini,
+			MaxMinRatio: t.MaxMinRatio,
+			StdDev:      t.StdDev,
+			Imbalanced:  t.Imbalanced,
+		})
+	}
+
+	return resp, nil
+}
+
+// CheckImbalance сравнивает текущее состояние команд с последним записанным
+// и на каждый переход через порог пишет в лог и сохраняет событие.
+// Возвращает сохранённые события.
+func (s *StatsService) CheckImbalance(ctx context.Context) ([]entity.ImbalanceEvent, error) {
+	if s.cfg.ImbalanceThreshold <= 0 {
+		return nil, nil
+	}
+
+	teams, err := s.teamFairness(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	states, err := s.repo.GetImbalanceStates(ctx)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get imbalance states", zap.Error(err))
+		return nil, err
+	}
+
+	events := []entity.ImbalanceEvent{}
+	for _, t := range teams {
+		if t.Imbalanced == states[t.TeamName] {
+			continue
+		}
+
+		if t.Imbalanced {
+			s.logger.Warn(ctx, "Team review load became imbalanced",
+				zap.String("team_name", t.TeamName),
+				zap.Float64("gini", t.Gini),
+				zap.Float64("threshold", s.cfg.ImbalanceThreshold),
+				zap.Float64("std_dev", t.StdDev),
+			)
+		} else {
+			s.logger.Info(ctx, "Team review load is balanced again",
+				zap.String("team_name", t.TeamName),
+				zap.Float64("gini", t.Gini),
+			)
+		}
+
+		events = append(events, entity.ImbalanceEvent{
+			TeamName:   t.TeamName,
+			Imbalanced: t.Imbalanced,
+			Gini:       t.Gini,
+			Threshold:  s.cfg.ImbalanceThreshold,
+		})
+	}
+
+	if len(events) == 0 {
+		return events, nil
+	}
+	if err := s.repo.RecordImbalanceEvents(ctx, events); err != nil {
+		s.logger.Error(ctx, "Failed to record imbalance events", zap.Error(err))
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// RunFairnessJob раз в interval вызывает CheckImbalance, пока не отменён ctx.
+func (s *StatsService) RunFairnessJob(ctx context.Context, interval time.Duration) {
+	s.logger.Info(ctx, "Fairness job started", zap.Duration("interval", interval))
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info(context.Background(), "Fairness job stopped")
+			return
+		case <-ticker.C:
+			if _, err := s.CheckImbalance(ctx); err != nil {
+				s.logger.Error(ctx, "Fairness job iteration failed", zap.Error(err))
+			}
+		}
+	}
+}
+
+func (s *StatsService) teamFairness(ctx context.Context, teamName string) ([]entity.TeamFairness, error) {
+	loads, err := s.repo.GetMemberLoads(ctx, teamName)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get member loads", zap.String("team_name", teamName), zap.Error(err))
+		return nil, err
+	}
+
+	// Строки отсортированы по команде.
+	teams := []entity.TeamFairness{}
+	for start := 0; start < len(loads); {
+		end := start
+		values := []int{}
+		for end < len(loads) && loads[end].TeamName == loads[start].TeamName {
+			values = append(values, loads[end].OpenReviews)
+			end++
+		}
+
+		t := fairness(values)
+		t.TeamName = loads[start].TeamName
+		t.Imbalanced = s.cfg.ImbalanceThreshold > 0 && t.Gini > s.cfg.ImbalanceThreshold
+		teams = append(teams, t)
+
+		start = end
+	}
+
+	return teams, nil
+}
+
+// fairness считает коэффициент Джини, отношение максимума к минимуму и
+// стандартное отклонение (по генеральной совокупности) нагрузок loads.
+func fairness(loads []int) entity.TeamFairness {
+	t := entity.TeamFairness{Members: len(loads)}
+	if len(loads) == 0 {
+		return t
+	}
+
+	sorted := slices.Clone(loads)
+	slices.Sort(sorted)
+
+	n := float64(len(sorted))
+	var sum, weighted float64
+	for i, v := range sorted {
+		sum += float64(v)
+		weighted += float64(i+1) * float64(v)
+	}
+	t.OpenReviews = int(sum)
+
+	if sum > 0 {
+		t.Gini = 2*weighted/(n*sum) - (n+1)/n
+	}
+
+	lo, hi := sorted[0], sorted[len(sorted)-1]
+	switch {
+	case hi == 0:
+		ratio := 1.0
+		t.MaxMinRatio = &ratio
+	case lo > 0:
+		ratio := float64(hi) / float64(lo)
+		t.MaxMinRatio = &ratio
+	}
+
+	mean := sum / n
+	var variance float64
+	for _, v := range sorted {
+		variance += (float64(v) - mean) * (float64(v) - mean)
+	}
+	t.StdDev = math.Sqrt(variance / n)
+
+	return t
+}
@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+
+	"pr_reviewer_assignment_service/internal/dto/stats"
+	"pr_reviewer_assignment_service/internal/entity"
+
+	"go.uber.org/zap"
+)
+
+// GetLatency возвращает перцентили времени до первого ревью и до merge по
+// командам и ревьюверам. Замер попадает в интервал по моменту своего
+// окончания: первого вердикта или merge.
+//
+// Для команды время до первого ревью отсчитывается от первого назначения
+// ревьювера на PR, время до merge — от создания PR. Для ревьювера оба
+// замера отсчитываются от его собственного назначения.
+func (s *StatsService) GetLatency(ctx context.Context, req *stats.StatsRequest) (*stats.LatencyResponse, error) {
+	s.logger.Info(ctx, "GetLatency called",
+		zap.String("team_name", req.TeamName),
+		zap.String("from", req.From),
+		zap.String("to", req.To),
+	)
+
+	filter, err := s.parseFilter(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkTeam(ctx, filter.TeamName); err != nil {
+		return nil, err
+	}
+
+	teamRows, err := s.repo.GetTeamLatency(ctx, filter)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get team latency", zap.Error(err))
+		return nil, err
+	}
+
+	reviewerRows, err := s.repo.GetReviewerLatency(ctx, filter)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get reviewer latency", zap.Error(err))
+		return nil, err
+	}
+
+	resp := &stats.LatencyResponse{
+		From:      formatTime(filter.From),
+		To:        formatTime(filter.To),
+		Teams:     []stats.TeamLatencyDTO{},
+		Reviewers: []stats.ReviewerLatencyDTO{},
+	}
+
+	// Строки приходят отсортированными по команде (ревьюверу), по одной на
+	// метрику, поэтому соседние строки одного владельца склеиваются.
+	for _, row := range teamRows {
+		if n := len(resp.Teams); n == 0 || resp.Teams[n-1].TeamName != row.TeamName {
+			resp.Teams = append(resp.Teams, stats.TeamLatencyDTO{TeamName: row.TeamName})
+		}
+		setPercentiles(&resp.Teams[len(resp.Teams)-1].LatencyDTO, row)
+	}
+	for _, row := range reviewerRows {
+		if n := len(resp.Reviewers); n == 0 || resp.Reviewers[n-1].UserID != row.UserID {
+			resp.Reviewers = append(resp.Reviewers, stats.ReviewerLatencyDTO{UserID: row.UserID, Username: row.Username})
+		}
+		setPercentiles(&resp.Reviewers[len(resp.Reviewers)-1].LatencyDTO, row)
+	}
+
+	return resp, nil
+}
+
+func setPercentiles(dst *stats.LatencyDTO, row entity.LatencyStats) {
+	p := &stats.PercentilesDTO{
+		Samples:    row.Samples,
+		P50Seconds: row.P50,
+		P90Seconds: row.P90,
+		P99Seconds: row.P99,
+	}
+
+	switch row.Metric {
+	case entity.LatencyFirstReview:
+		dst.TimeToFirstReview = p
+	case entity.LatencyMerge:
+		dst.TimeToMerge = p
+	}
+}
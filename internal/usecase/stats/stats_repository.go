@@ -0,0 +1,17 @@
+package usecase
+
+import (
+	"context"
+	"pr_reviewer_assignment_service/internal/entity"
+)
+
+type StatsRepository interface {
+	TeamExists(ctx context.Context, teamName string) (bool, error)
+	GetReviewerStats(ctx context.Context, filter entity.StatsFilter) ([]entity.ReviewerStats, error)
+	GetTeamReviewStats(ctx context.Context, filter entity.StatsFilter) ([]entity.TeamReviewStats, error)
+	GetTeamLatency(ctx context.Context, filter entity.StatsFilter) ([]entity.LatencyStats, error)
+	GetReviewerLatency(ctx context.Context, filter entity.StatsFilter) ([]entity.LatencyStats, error)
+	GetMemberLoads(ctx context.Context, teamName string) ([]entity.MemberLoad, error)
+	GetImbalanceStates(ctx context.Context) (map[string]bool, error)
+	RecordImbalanceEvents(ctx context.Context, events []entity.ImbalanceEvent) error
+}
@@ -0,0 +1,154 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"pr_reviewer_assignment_service/internal/dto"
+	"pr_reviewer_assignment_service/internal/dto/stats"
+	"pr_reviewer_assignment_service/internal/entity"
+	"pr_reviewer_assignment_service/pkg/logger"
+
+	"go.uber.org/zap"
+)
+
+type Config struct {
+	// ImbalanceThreshold — коэффициент Джини открытых ревью, выше которого
+	// команда считается несбалансированной; 0 выключает проверку.
+	ImbalanceThreshold float64
+}
+
+type StatsService struct {
+	repo   StatsRepository
+	cfg    Config
+	logger logger.Logger
+}
+
+func NewStatsService(repo StatsRepository, cfg Config, logger logger.Logger) *StatsService {
+	return &StatsService{repo: repo, cfg: cfg, logger: logger}
+}
+
+func (s *StatsService) Logger() logger.Logger {
+	return s.logger
+}
+
+// GetReviewerStats возвращает распределение ревью по пользователям и
+// командам. При фильтре по команде в ответ попадают только её участники.
+func (s *StatsService) GetReviewerStats(ctx context.Context, req *stats.StatsRequest) (*stats.ReviewerStatsResponse, error) {
+	s.logger.Info(ctx, "GetReviewerStats called",
+		zap.String("team_name", req.TeamName),
+		zap.String("from", req.From),
+		zap.String("to", req.To),
+	)
+
+	filter, err := s.parseFilter(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkTeam(ctx, filter.TeamName); err != nil {
+		return nil, err
+	}
+
+	teams, err := s.repo.GetTeamReviewStats(ctx, filter)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get team review stats", zap.Error(err))
+		return nil, err
+	}
+
+	users, err := s.repo.GetReviewerStats(ctx, filter)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get reviewer stats", zap.Error(err))
+		return nil, err
+	}
+
+	resp := &stats.ReviewerStatsResponse{
+		From:  formatTime(filter.From),
+		To:    formatTime(filter.To),
+		Users: make([]stats.ReviewerStatsDTO, 0, len(users)),
+		Teams: make([]stats.TeamReviewStatsDTO, 0, len(teams)),
+	}
+	for _, u := range users {
+		resp.Users = append(resp.Users, stats.ReviewerStatsDTO{
+			UserID:            u.UserID,
+			Username:          u.Username,
+			TeamName:          u.TeamName,
+			ReviewCountersDTO: toCountersDTO(u.ReviewCounters),
+		})
+	}
+	for _, t := range teams {
+		resp.Teams = append(resp.Teams, stats.TeamReviewStatsDTO{
+			TeamName:          t.TeamName,
+			Members:           t.Members,
+			ReviewCountersDTO: toCountersDTO(t.ReviewCounters),
+		})
+	}
+
+	return resp, nil
+}
+
+// parseFilter разбирает фильтры запроса; границы интервала — RFC3339.
+func (s *StatsService) parseFilter(ctx context.Context, req *stats.StatsRequest) (entity.StatsFilter, error) {
+	from, err := parseBound(req.From)
+	if err != nil {
+		s.logger.Warn(ctx, "Invalid from bound", zap.String("from", req.From), zap.Error(err))
+		return entity.StatsFilter{}, dto.ErrInvalidTimeRange
+	}
+	to, err := parseBound(req.To)
+	if err != nil {
+		s.logger.Warn(ctx, "Invalid to bound", zap.String("to", req.To), zap.Error(err))
+		return entity.StatsFilter{}, dto.ErrInvalidTimeRange
+	}
+
+	if from != nil && to != nil && !from.Before(*to) {
+		s.logger.Warn(ctx, "Empty time range", zap.String("from", req.From), zap.String("to", req.To))
+		return entity.StatsFilter{}, dto.ErrInvalidTimeRange
+	}
+
+	return entity.StatsFilter{TeamName: req.TeamName, From: from, To: to}, nil
+}
+
+// checkTeam возвращает dto.ErrNotFound, если фильтр задаёт несуществующую
+// команду.
+func (s *StatsService) checkTeam(ctx context.Context, teamName string) error {
+	if teamName == "" {
+		return nil
+	}
+	exists, err := s.repo.TeamExists(ctx, teamName)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		s.logger.Warn(ctx, "Team not found", zap.String("team_name", teamName))
+		return dto.ErrNotFound
+	}
+	return nil
+}
+
+func parseBound(raw string) (*time.Time, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return nil, err
+	}
+	t = t.UTC()
+	return &t, nil
+}
+
+func toCountersDTO(c entity.ReviewCounters) stats.ReviewCountersDTO {
+	return stats.ReviewCountersDTO{
+		Assignments:     c.Assignments,
+		OpenAssignments: c.OpenAssignments,
+		ReassignedAway:  c.ReassignedAway,
+		MergedReviewed:  c.MergedReviewed,
+	}
+}
+
+func formatTime(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	s := t.UTC().Format(time.RFC3339)
+	return &s
+}
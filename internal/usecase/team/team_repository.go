@@ -8,4 +8,23 @@ import (
 type TeamRepository interface {
 	CreateTeam(ctx context.Context, team *entity.Team) error
 	GetTeamByName(ctx context.Context, teamName string) (*entity.Team, error)
+	GetTeamWithArchived(ctx context.Context, teamName string) (*entity.Team, error)
+	UpdateTeam(ctx context.Context, update *entity.TeamUpdate, plan []entity.Reassignment) ([]entity.Reassignment, error)
+	DeleteTeam(ctx context.Context, teamName string) error
+	ArchiveTeam(ctx context.Context, teamName string, plan []entity.Reassignment) ([]entity.Reassignment, error)
+	UpdateMembership(ctx context.Context, teamName, userID string, role *string, isActive *bool) (*entity.Membership, error)
+	GetFallbackTeams(ctx context.Context, teamName string) ([]string, error)
+	SetFallbackTeams(ctx context.Context, teamName string, fallbacks []string) error
+	SetMaxOpenReviews(ctx context.Context, teamName string, limit *int) error
+	GetMergePolicy(ctx context.Context, teamName string) (*entity.MergePolicy, error)
+	SetMergePolicy(ctx context.Context, policy *entity.MergePolicy) error
+	DeactivateMembers(ctx context.Context, teamName string, userIDs []string, plan []entity.Reassignment) ([]entity.Reassignment, error)
+	ActiveInOtherTeams(ctx context.Context, teamName string, userIDs []string) ([]string, error)
+}
+
+// ReviewReassigner переназначает открытые ревью пользователя на других
+// участников команды. Реализуется PR-сервисом.
+type ReviewReassigner interface {
+	PlanTeamReassignments(ctx context.Context, userIDs []string, teamName string) ([]entity.Reassignment, error)
+	PlanArchiveReassignments(ctx context.Context, userIDs []string, teamName string, staying []string) ([]entity.Reassignment, error)
 }
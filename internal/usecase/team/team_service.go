@@ -4,30 +4,47 @@ import (
 	"context"
 	"errors"
 	"pr_reviewer_assignment_service/internal/dto"
+	"pr_reviewer_assignment_service/internal/dto/pr"
 	team "pr_reviewer_assignment_service/internal/dto/team"
 
 	"pr_reviewer_assignment_service/internal/entity"
 	"pr_reviewer_assignment_service/pkg/logger"
+	"time"
 
 	"go.uber.org/zap"
 )
 
+const (
+	TeamDeleteHard    = "hard"
+	TeamDeleteArchive = "archive"
+)
+
 type TeamService struct {
-	repo   TeamRepository
-	logger logger.Logger
+	repo       TeamRepository
+	reassigner ReviewReassigner
+	logger     logger.Logger
 }
 
 func (s *TeamService) Logger() logger.Logger {
 	return s.logger
 }
 
-func NewTeamService(repo TeamRepository, logger logger.Logger) *TeamService {
-	return &TeamService{repo: repo, logger: logger}
+func NewTeamService(repo TeamRepository, reassigner ReviewReassigner, logger logger.Logger) *TeamService {
+	return &TeamService{repo: repo, reassigner: reassigner, logger: logger}
 }
 
 func (s *TeamService) CreateTeam(ctx context.Context, req *team.TeamRequest) (*team.TeamResponse, error) {
 	s.logger.Info(ctx, "CreateTeam called", zap.String("team_name", req.TeamName))
 
+	if req.MaxReviewers != nil && *req.MaxReviewers < 0 {
+		s.logger.Warn(ctx, "Invalid max_reviewers", zap.String("team_name", req.TeamName), zap.Int("max_reviewers", *req.MaxReviewers))
+		return nil, dto.ErrInvalidReviewersCount
+	}
+	if !validLimit(req.MaxOpenReviews) {
+		s.logger.Warn(ctx, "Invalid max_open_reviews", zap.String("team_name", req.TeamName))
+		return nil, dto.ErrInvalidCapacity
+	}
+
 	existing, err := s.repo.GetTeamByName(ctx, req.TeamName)
 	if err != nil && !errors.Is(err, dto.ErrNotFound) {
 		s.logger.Error(ctx, "Error checking existing team", zap.String("team_name", req.TeamName), zap.Error(err))
@@ -40,17 +57,29 @@ func (s *TeamService) CreateTeam(ctx context.Context, req *team.TeamRequest) (*t
 
 	var members []entity.User
 	for _, m := range req.Members {
+		if !validRole(m.Role) {
+			s.logger.Warn(ctx, "Invalid member role", zap.String("user_id", m.UserID), zap.String("role", m.Role))
+			return nil, dto.ErrInvalidMembership
+		}
+		if !validLimit(m.MaxOpenReviews) {
+			s.logger.Warn(ctx, "Invalid member max_open_reviews", zap.String("user_id", m.UserID))
+			return nil, dto.ErrInvalidCapacity
+		}
 		members = append(members, entity.User{
-			UserID:   m.UserID,
-			Username: m.Username,
-			TeamName: req.TeamName,
-			IsActive: m.IsActive,
+			UserID:         m.UserID,
+			Username:       m.Username,
+			TeamName:       req.TeamName,
+			IsActive:       m.IsActive,
+			Role:           m.Role,
+			MaxOpenReviews: m.MaxOpenReviews,
 		})
 	}
 
 	teamEntity := &entity.Team{
-		TeamName: req.TeamName,
-		Members:  members,
+		TeamName:       req.TeamName,
+		MaxReviewers:   req.MaxReviewers,
+		MaxOpenReviews: req.MaxOpenReviews,
+		Members:        members,
 	}
 
 	if err := s.repo.CreateTeam(ctx, teamEntity); err != nil {
@@ -61,17 +90,27 @@ func (s *TeamService) CreateTeam(ctx context.Context, req *team.TeamRequest) (*t
 	s.logger.Info(ctx, "Team created successfully", zap.String("team_name", req.TeamName), zap.Int("members_count", len(members)))
 
 	resp := &team.TeamResponse{
-		TeamName: teamEntity.TeamName,
-		Members:  req.Members,
+		TeamName:       teamEntity.TeamName,
+		MaxReviewers:   teamEntity.MaxReviewers,
+		MaxOpenReviews: teamEntity.MaxOpenReviews,
+		Members:        req.Members,
 	}
 
 	return resp, nil
 }
 
-func (s *TeamService) GetTeamByName(ctx context.Context, teamName string) (*team.TeamResponse, error) {
-	s.logger.Info(ctx, "GetTeamByName called", zap.String("team_name", teamName))
+// GetTeamByName возвращает команду; архивные команды отдаются только
+// при includeArchived.
+func (s *TeamService) GetTeamByName(ctx context.Context, teamName string, includeArchived bool) (*team.TeamResponse, error) {
+	s.logger.Info(ctx, "GetTeamByName called", zap.String("team_name", teamName), zap.Bool("include_archived", includeArchived))
 
-	t, err := s.repo.GetTeamByName(ctx, teamName)
+	var t *entity.Team
+	var err error
+	if includeArchived {
+		t, err = s.repo.GetTeamWithArchived(ctx, teamName)
+	} else {
+		t, err = s.repo.GetTeamByName(ctx, teamName)
+	}
 	if err != nil {
 		s.logger.Error(ctx, "Team not found or error", zap.String("team_name", teamName), zap.Error(err))
 		return nil, err
@@ -80,18 +119,429 @@ func (s *TeamService) GetTeamByName(ctx context.Context, teamName string) (*team
 	var members []team.TeamMember
 	for _, u := range t.Members {
 		members = append(members, team.TeamMember{
-			UserID:   u.UserID,
-			Username: u.Username,
-			IsActive: u.IsActive,
+			UserID:         u.UserID,
+			Username:       u.Username,
+			IsActive:       u.IsActive,
+			Role:           u.Role,
+			MaxOpenReviews: u.MaxOpenReviews,
+			Absent:         u.Absent,
 		})
 	}
 
 	s.logger.Info(ctx, "Team retrieved successfully", zap.String("team_name", t.TeamName), zap.Int("members_count", len(members)))
 
 	resp := &team.TeamResponse{
-		TeamName: t.TeamName,
-		Members:  members,
+		TeamName:       t.TeamName,
+		MaxReviewers:   t.MaxReviewers,
+		MaxOpenReviews: t.MaxOpenReviews,
+		ArchivedAt:     formatTime(t.ArchivedAt),
+		Members:        members,
 	}
 
 	return resp, nil
 }
+
+func (s *TeamService) UpdateTeam(ctx context.Context, req *team.UpdateTeamRequest) (*team.UpdateTeamResponse, error) {
+	s.logger.Info(ctx, "UpdateTeam called",
+		zap.String("team_name", req.TeamName),
+		zap.Int("add", len(req.AddMembers)),
+		zap.Int("remove", len(req.RemoveMembers)),
+		zap.Int("rename", len(req.RenameMembers)),
+	)
+
+	// Один пользователь может встречаться только в одной операции.
+	seen := map[string]bool{}
+	touch := func(userID string) bool {
+		if userID == "" || seen[userID] {
+			return false
+		}
+		seen[userID] = true
+		return true
+	}
+
+	update := &entity.TeamUpdate{TeamName: req.TeamName}
+	for _, m := range req.AddMembers {
+		if !touch(m.UserID) {
+			s.logger.Warn(ctx, "Conflicting team update", zap.String("user_id", m.UserID))
+			return nil, dto.ErrInvalidTeamUpdate
+		}
+		if !validRole(m.Role) {
+			s.logger.Warn(ctx, "Invalid member role", zap.String("user_id", m.UserID), zap.String("role", m.Role))
+			return nil, dto.ErrInvalidMembership
+		}
+		update.Add = append(update.Add, entity.User{
+			UserID:   m.UserID,
+			Username: m.Username,
+			TeamName: req.TeamName,
+			IsActive: m.IsActive,
+			Role:     m.Role,
+		})
+	}
+	for _, userID := range req.RemoveMembers {
+		if !touch(userID) {
+			s.logger.Warn(ctx, "Conflicting team update", zap.String("user_id", userID))
+			return nil, dto.ErrInvalidTeamUpdate
+		}
+		update.Remove = append(update.Remove, userID)
+	}
+	for _, m := range req.RenameMembers {
+		if !touch(m.UserID) || m.Username == "" {
+			s.logger.Warn(ctx, "Conflicting team update", zap.String("user_id", m.UserID))
+			return nil, dto.ErrInvalidTeamUpdate
+		}
+		update.Rename = append(update.Rename, entity.User{UserID: m.UserID, Username: m.Username, TeamName: req.TeamName})
+	}
+
+	plan := []entity.Reassignment{}
+	if len(update.Remove) > 0 {
+		var err error
+		plan, err = s.reassigner.PlanTeamReassignments(ctx, update.Remove, req.TeamName)
+		if err != nil {
+			s.logger.Error(ctx, "Failed to plan reassignments of removed members", zap.String("team_name", req.TeamName), zap.Error(err))
+			return nil, err
+		}
+	}
+
+	applied, err := s.repo.UpdateTeam(ctx, update, plan)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to update team", zap.String("team_name", req.TeamName), zap.Error(err))
+		return nil, err
+	}
+	reassignments := toReassignmentDTOs(applied)
+
+	resp, err := s.GetTeamByName(ctx, req.TeamName, false)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info(ctx, "Team updated successfully", zap.String("team_name", req.TeamName), zap.Int("reassignments", len(reassignments)))
+
+	return &team.UpdateTeamResponse{Team: *resp, Reassignments: reassignments}, nil
+}
+
+// DeleteTeam удаляет команду без истории PR (TeamDeleteHard) или архивирует
+// её (TeamDeleteArchive): участники деактивируются, а их открытые ревью
+// переназначаются или снимаются.
+func (s *TeamService) DeleteTeam(ctx context.Context, req *team.DeleteTeamRequest) (*team.DeleteTeamResponse, error) {
+	s.logger.Info(ctx, "DeleteTeam called", zap.String("team_name", req.TeamName), zap.String("mode", req.Mode))
+
+	resp := &team.DeleteTeamResponse{
+		TeamName:      req.TeamName,
+		Mode:          req.Mode,
+		Reassignments: []pr.ReassignmentDTO{},
+	}
+
+	switch req.Mode {
+	case TeamDeleteHard:
+		if err := s.repo.DeleteTeam(ctx, req.TeamName); err != nil {
+			s.logger.Error(ctx, "Failed to delete team", zap.String("team_name", req.TeamName), zap.Error(err))
+			return nil, err
+		}
+	case TeamDeleteArchive:
+		applied, err := s.archiveTeam(ctx, req.TeamName)
+		if err != nil {
+			return nil, err
+		}
+		resp.Reassignments = toReassignmentDTOs(applied)
+	default:
+		s.logger.Warn(ctx, "Invalid delete mode", zap.String("mode", req.Mode))
+		return nil, dto.ErrInvalidDeleteMode
+	}
+
+	s.logger.Info(ctx, "Team deleted successfully",
+		zap.String("team_name", req.TeamName),
+		zap.String("mode", req.Mode),
+		zap.Int("reassignments", len(resp.Reassignments)),
+	)
+	return resp, nil
+}
+
+// archiveTeam планирует переназначение открытых ревью участников команды и
+// архивирует её вместе с применением плана. У участников, остающихся
+// активными в других командах, снимаются только ревью в PR этой команды.
+func (s *TeamService) archiveTeam(ctx context.Context, teamName string) ([]entity.Reassignment, error) {
+	t, err := s.repo.GetTeamWithArchived(ctx, teamName)
+	if err != nil {
+		s.logger.Error(ctx, "Team not found or error", zap.String("team_name", teamName), zap.Error(err))
+		return nil, err
+	}
+	if t.ArchivedAt != nil {
+		s.logger.Warn(ctx, "Team is already archived", zap.String("team_name", teamName))
+		return nil, dto.ErrTeamArchived
+	}
+
+	members := make([]string, 0, len(t.Members))
+	for _, m := range t.Members {
+		members = append(members, m.UserID)
+	}
+
+	plan := []entity.Reassignment{}
+	if len(members) > 0 {
+		staying, err := s.repo.ActiveInOtherTeams(ctx, teamName, members)
+		if err != nil {
+			s.logger.Error(ctx, "Failed to get members active in other teams", zap.String("team_name", teamName), zap.Error(err))
+			return nil, err
+		}
+		plan, err = s.reassigner.PlanArchiveReassignments(ctx, members, teamName, staying)
+		if err != nil {
+			s.logger.Error(ctx, "Failed to plan reassignments of archived members", zap.String("team_name", teamName), zap.Error(err))
+			return nil, err
+		}
+	}
+
+	applied, err := s.repo.ArchiveTeam(ctx, teamName, plan)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to archive team", zap.String("team_name", teamName), zap.Error(err))
+		return nil, err
+	}
+	return applied, nil
+}
+
+// DeactivateMembers выключает участие указанных пользователей в команде
+// (или всех её участников при All) и одной транзакцией переназначает их
+// открытые ревью в PR этой команды на оставшихся участников. Участие в
+// других командах и ревью в их PR не затрагиваются. PR, для которых замены
+// не нашлось, попадают в ответ с пустым new_user_id.
+func (s *TeamService) DeactivateMembers(ctx context.Context, req *team.DeactivateUsersRequest) (*team.DeactivateUsersResponse, error) {
+	s.logger.Info(ctx, "DeactivateMembers called",
+		zap.String("team_name", req.TeamName),
+		zap.Int("users_count", len(req.UserIDs)),
+		zap.Bool("all", req.All),
+	)
+
+	if req.All == (len(req.UserIDs) > 0) {
+		s.logger.Warn(ctx, "Either user_ids or all must be set", zap.String("team_name", req.TeamName))
+		return nil, dto.ErrInvalidDeactivate
+	}
+
+	t, err := s.repo.GetTeamByName(ctx, req.TeamName)
+	if err != nil {
+		s.logger.Error(ctx, "Team not found or error", zap.String("team_name", req.TeamName), zap.Error(err))
+		return nil, err
+	}
+
+	members := make(map[string]bool, len(t.Members))
+	for _, m := range t.Members {
+		members[m.UserID] = true
+	}
+
+	userIDs := req.UserIDs
+	if req.All {
+		userIDs = make([]string, 0, len(t.Members))
+		for _, m := range t.Members {
+			userIDs = append(userIDs, m.UserID)
+		}
+	}
+
+	seen := make(map[string]bool, len(userIDs))
+	for _, userID := range userIDs {
+		if seen[userID] {
+			s.logger.Warn(ctx, "Duplicate user in deactivation", zap.String("user_id", userID))
+			return nil, dto.ErrInvalidDeactivate
+		}
+		if !members[userID] {
+			s.logger.Warn(ctx, "User is not a team member", zap.String("team_name", req.TeamName), zap.String("user_id", userID))
+			return nil, dto.ErrNotTeamMember
+		}
+		seen[userID] = true
+	}
+
+	plan, err := s.reassigner.PlanTeamReassignments(ctx, userIDs, req.TeamName)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to plan reassignments", zap.String("team_name", req.TeamName), zap.Error(err))
+		return nil, err
+	}
+
+	applied, err := s.repo.DeactivateMembers(ctx, req.TeamName, userIDs, plan)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to deactivate members", zap.String("team_name", req.TeamName), zap.Error(err))
+		return nil, err
+	}
+
+	s.logger.Info(ctx, "Team members deactivated",
+		zap.String("team_name", req.TeamName),
+		zap.Int("deactivated", len(userIDs)),
+		zap.Int("reassignments", len(applied)),
+	)
+
+	return &team.DeactivateUsersResponse{
+		TeamName:      req.TeamName,
+		Deactivated:   userIDs,
+		Reassignments: toReassignmentDTOs(applied),
+	}, nil
+}
+
+// SetMembership меняет роль и/или активность участия пользователя в команде.
+func (s *TeamService) SetMembership(ctx context.Context, req *team.SetMembershipRequest) (*team.MembershipResponse, error) {
+	s.logger.Info(ctx, "SetMembership called", zap.String("team_name", req.TeamName), zap.String("user_id", req.UserID))
+
+	if (req.Role == nil && req.IsActive == nil) || (req.Role != nil && (*req.Role == "" || !validRole(*req.Role))) {
+		s.logger.Warn(ctx, "Invalid membership update", zap.String("team_name", req.TeamName), zap.String("user_id", req.UserID))
+		return nil, dto.ErrInvalidMembership
+	}
+
+	m, err := s.repo.UpdateMembership(ctx, req.TeamName, req.UserID, req.Role, req.IsActive)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to update membership", zap.String("team_name", req.TeamName), zap.String("user_id", req.UserID), zap.Error(err))
+		return nil, err
+	}
+
+	s.logger.Info(ctx, "Membership updated", zap.String("team_name", m.TeamName), zap.String("user_id", m.UserID), zap.String("role", m.Role), zap.Bool("is_active", m.IsActive))
+
+	return &team.MembershipResponse{
+		TeamName: m.TeamName,
+		UserID:   m.UserID,
+		Role:     m.Role,
+		IsActive: m.IsActive,
+		JoinedAt: m.JoinedAt.UTC().Format(time.RFC3339),
+	}, nil
+}
+
+// SetMaxOpenReviews задаёт лимит открытых ревью по умолчанию для участников
+// команды без личного лимита; nil снимает лимит.
+func (s *TeamService) SetMaxOpenReviews(ctx context.Context, req *team.SetMaxOpenReviewsRequest) (*team.TeamResponse, error) {
+	s.logger.Info(ctx, "SetMaxOpenReviews called", zap.String("team_name", req.TeamName))
+
+	if !validLimit(req.MaxOpenReviews) {
+		s.logger.Warn(ctx, "Invalid max_open_reviews", zap.String("team_name", req.TeamName))
+		return nil, dto.ErrInvalidCapacity
+	}
+
+	if err := s.repo.SetMaxOpenReviews(ctx, req.TeamName, req.MaxOpenReviews); err != nil {
+		s.logger.Error(ctx, "Failed to set max open reviews", zap.String("team_name", req.TeamName), zap.Error(err))
+		return nil, err
+	}
+
+	return s.GetTeamByName(ctx, req.TeamName, false)
+}
+
+// SetFallbackTeams задаёт резервные команды, из которых добираются
+// ревьюверы, если в самой команде кандидатов не хватает. Порядок в списке —
+// порядок приоритета.
+func (s *TeamService) SetFallbackTeams(ctx context.Context, req *team.FallbackTeamsDTO) (*team.FallbackTeamsDTO, error) {
+	s.logger.Info(ctx, "SetFallbackTeams called", zap.String("team_name", req.TeamName), zap.Strings("fallback_teams", req.FallbackTeams))
+
+	seen := map[string]bool{}
+	for _, name := range req.FallbackTeams {
+		if name == "" || name == req.TeamName || seen[name] {
+			s.logger.Warn(ctx, "Invalid fallback team", zap.String("team_name", req.TeamName), zap.String("fallback_team", name))
+			return nil, dto.ErrInvalidFallback
+		}
+		seen[name] = true
+
+		if _, err := s.repo.GetTeamByName(ctx, name); err != nil {
+			s.logger.Error(ctx, "Fallback team not found or error", zap.String("fallback_team", name), zap.Error(err))
+			return nil, err
+		}
+	}
+
+	if err := s.repo.SetFallbackTeams(ctx, req.TeamName, req.FallbackTeams); err != nil {
+		s.logger.Error(ctx, "Failed to save fallback teams", zap.String("team_name", req.TeamName), zap.Error(err))
+		return nil, err
+	}
+
+	s.logger.Info(ctx, "Fallback teams saved", zap.String("team_name", req.TeamName))
+	return &team.FallbackTeamsDTO{TeamName: req.TeamName, FallbackTeams: append([]string{}, req.FallbackTeams...)}, nil
+}
+
+func (s *TeamService) GetFallbackTeams(ctx context.Context, teamName string) (*team.FallbackTeamsDTO, error) {
+	s.logger.Info(ctx, "GetFallbackTeams called", zap.String("team_name", teamName))
+
+	if _, err := s.repo.GetTeamByName(ctx, teamName); err != nil {
+		s.logger.Error(ctx, "Team not found or error", zap.String("team_name", teamName), zap.Error(err))
+		return nil, err
+	}
+
+	fallbacks, err := s.repo.GetFallbackTeams(ctx, teamName)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get fallback teams", zap.String("team_name", teamName), zap.Error(err))
+		return nil, err
+	}
+
+	return &team.FallbackTeamsDTO{TeamName: teamName, FallbackTeams: fallbacks}, nil
+}
+
+func (s *TeamService) SetMergePolicy(ctx context.Context, req *team.MergePolicyDTO) (*team.MergePolicyDTO, error) {
+	s.logger.Info(ctx, "SetMergePolicy called", zap.String("team_name", req.TeamName))
+
+	if req.MinApprovals < 0 {
+		s.logger.Warn(ctx, "Invalid min_approvals", zap.String("team_name", req.TeamName), zap.Int("min_approvals", req.MinApprovals))
+		return nil, dto.ErrInvalidPolicy
+	}
+
+	if _, err := s.repo.GetTeamByName(ctx, req.TeamName); err != nil {
+		s.logger.Error(ctx, "Team not found or error", zap.String("team_name", req.TeamName), zap.Error(err))
+		return nil, err
+	}
+
+	if req.RequiredReviewerTeam != nil {
+		if _, err := s.repo.GetTeamByName(ctx, *req.RequiredReviewerTeam); err != nil {
+			s.logger.Error(ctx, "Required reviewer team not found or error", zap.String("team_name", *req.RequiredReviewerTeam), zap.Error(err))
+			return nil, err
+		}
+	}
+
+	policy := &entity.MergePolicy{
+		TeamName:                 req.TeamName,
+		MinApprovals:             req.MinApprovals,
+		BlockOnChangesRequested:  req.BlockOnChangesRequested,
+		ForbidAuthorOnlyApproval: req.ForbidAuthorOnlyApproval,
+		RequiredReviewerTeam:     req.RequiredReviewerTeam,
+	}
+
+	if err := s.repo.SetMergePolicy(ctx, policy); err != nil {
+		s.logger.Error(ctx, "Failed to save merge policy", zap.String("team_name", req.TeamName), zap.Error(err))
+		return nil, err
+	}
+
+	s.logger.Info(ctx, "Merge policy saved", zap.String("team_name", req.TeamName))
+	return req, nil
+}
+
+func (s *TeamService) GetMergePolicy(ctx context.Context, teamName string) (*team.MergePolicyDTO, error) {
+	s.logger.Info(ctx, "GetMergePolicy called", zap.String("team_name", teamName))
+
+	policy, err := s.repo.GetMergePolicy(ctx, teamName)
+	if err != nil {
+		s.logger.Error(ctx, "Merge policy not found or error", zap.String("team_name", teamName), zap.Error(err))
+		return nil, err
+	}
+
+	return &team.MergePolicyDTO{
+		TeamName:                 policy.TeamName,
+		MinApprovals:             policy.MinApprovals,
+		BlockOnChangesRequested:  policy.BlockOnChangesRequested,
+		ForbidAuthorOnlyApproval: policy.ForbidAuthorOnlyApproval,
+		RequiredReviewerTeam:     policy.RequiredReviewerTeam,
+	}, nil
+}
+
+func toReassignmentDTOs(moved []entity.Reassignment) []pr.ReassignmentDTO {
+	result := make([]pr.ReassignmentDTO, 0, len(moved))
+	for _, r := range moved {
+		result = append(result, pr.ReassignmentDTO{
+			PullRequestID: r.PullRequestID,
+			OldUserID:     r.OldUserID,
+			NewUserID:     r.NewUserID,
+			Released:      r.Released,
+		})
+	}
+	return result
+}
+
+func formatTime(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	s := t.UTC().Format(time.RFC3339)
+	return &s
+}
+
+// validRole допускает пустую роль — тогда участник получает RoleMember.
+func validRole(role string) bool {
+	return role == "" || role == entity.RoleMember || role == entity.RoleLead
+}
+
+func validLimit(limit *int) bool {
+	return limit == nil || *limit >= 0
+}
@@ -0,0 +1,160 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"pr_reviewer_assignment_service/internal/dto"
+	"pr_reviewer_assignment_service/internal/dto/user"
+	"pr_reviewer_assignment_service/internal/entity"
+
+	"go.uber.org/zap"
+)
+
+// AddAbsence регистрирует период отсутствия. Пока он идёт, пользователь не
+// назначается ревьювером; при ReassignOpenReviews его открытые ревью
+// переназначит фоновая задача, когда период начнётся.
+func (s *UserService) AddAbsence(ctx context.Context, req *user.AddAbsenceRequest) (*user.AbsenceResponse, error) {
+	s.logger.Info(ctx, "AddAbsence called",
+		zap.String("user_id", req.UserID),
+		zap.Time("starts_at", req.StartsAt),
+		zap.Time("ends_at", req.EndsAt),
+	)
+
+	if req.StartsAt.IsZero() || !req.EndsAt.After(req.StartsAt) {
+		s.logger.Warn(ctx, "Invalid absence period", zap.String("user_id", req.UserID))
+		return nil, dto.ErrInvalidAbsence
+	}
+
+	absence, err := s.repo.AddAbsence(ctx, &entity.Absence{
+		UserID:              req.UserID,
+		StartsAt:            req.StartsAt,
+		EndsAt:              req.EndsAt,
+		Reason:              req.Reason,
+		ReassignOpenReviews: req.ReassignOpenReviews,
+	})
+	if err != nil {
+		s.logger.Error(ctx, "Failed to add absence", zap.String("user_id", req.UserID), zap.Error(err))
+		return nil, err
+	}
+
+	s.logger.Info(ctx, "Absence added", zap.String("user_id", req.UserID), zap.Int64("absence_id", absence.AbsenceID))
+	resp := toAbsenceResponse(absence)
+	return &resp, nil
+}
+
+func (s *UserService) GetAbsences(ctx context.Context, userID string) (*user.GetAbsencesResponse, error) {
+	s.logger.Info(ctx, "GetAbsences called", zap.String("user_id", userID))
+
+	if _, err := s.repo.GetByID(ctx, userID); err != nil {
+		s.logger.Error(ctx, "User not found", zap.String("user_id", userID), zap.Error(err))
+		return nil, err
+	}
+
+	absences, err := s.repo.GetAbsences(ctx, userID)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get absences", zap.String("user_id", userID), zap.Error(err))
+		return nil, err
+	}
+
+	resp := &user.GetAbsencesResponse{UserID: userID, Absences: make([]user.AbsenceResponse, 0, len(absences))}
+	for i := range absences {
+		resp.Absences = append(resp.Absences, toAbsenceResponse(&absences[i]))
+	}
+
+	return resp, nil
+}
+
+func (s *UserService) DeleteAbsence(ctx context.Context, req *user.DeleteAbsenceRequest) error {
+	s.logger.Info(ctx, "DeleteAbsence called", zap.Int64("absence_id", req.AbsenceID))
+
+	if err := s.repo.DeleteAbsence(ctx, req.AbsenceID); err != nil {
+		s.logger.Error(ctx, "Failed to delete absence", zap.Int64("absence_id", req.AbsenceID), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// ReassignStartedAbsences переназначает открытые ревью пользователей, чей
+// период отсутствия уже начался и помечен ReassignOpenReviews: план
+// строится заранее и применяется вместе с отметкой периода в одной
+// транзакции. Ошибка по одному периоду не останавливает остальные: такой
+// период остаётся необработанным до следующего запуска. Возвращает число
+// обработанных периодов.
+func (s *UserService) ReassignStartedAbsences(ctx context.Context, now time.Time) (int, error) {
+	absences, err := s.repo.GetStartedAbsences(ctx, now)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get started absences", zap.Error(err))
+		return 0, err
+	}
+
+	processed := 0
+	for _, absence := range absences {
+		plan, err := s.reassigner.PlanReassignments(ctx, []string{absence.UserID})
+		if err != nil {
+			s.logger.Error(ctx, "Failed to plan reassignment of absent user's reviews",
+				zap.String("user_id", absence.UserID),
+				zap.Int64("absence_id", absence.AbsenceID),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		moved, err := s.repo.MarkAbsenceReassigned(ctx, absence.AbsenceID, now, plan)
+		if err != nil {
+			s.logger.Error(ctx, "Failed to reassign reviews of absent user",
+				zap.String("user_id", absence.UserID),
+				zap.Int64("absence_id", absence.AbsenceID),
+				zap.Error(err),
+			)
+			continue
+		}
+		processed++
+
+		s.logger.Info(ctx, "Reviews of absent user reassigned",
+			zap.String("user_id", absence.UserID),
+			zap.Int64("absence_id", absence.AbsenceID),
+			zap.Int("reassignments", len(moved)),
+		)
+	}
+
+	return processed, nil
+}
+
+// RunAbsenceJob раз в interval вызывает ReassignStartedAbsences, пока не
+// отменён ctx.
+func (s *UserService) RunAbsenceJob(ctx context.Context, interval time.Duration) {
+	s.logger.Info(ctx, "Absence job started", zap.Duration("interval", interval))
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info(context.Background(), "Absence job stopped")
+			return
+		case now := <-ticker.C:
+			if _, err := s.ReassignStartedAbsences(ctx, now); err != nil {
+				s.logger.Error(ctx, "Absence job iteration failed", zap.Error(err))
+			}
+		}
+	}
+}
+
+func toAbsenceResponse(a *entity.Absence) user.AbsenceResponse {
+	resp := user.AbsenceResponse{
+		AbsenceID:           a.AbsenceID,
+		UserID:              a.UserID,
+		StartsAt:            a.StartsAt.UTC().Format(time.RFC3339),
+		EndsAt:              a.EndsAt.UTC().Format(time.RFC3339),
+		Reason:              a.Reason,
+		ReassignOpenReviews: a.ReassignOpenReviews,
+	}
+	if a.ReassignedAt != nil {
+		reassignedAt := a.ReassignedAt.UTC().Format(time.RFC3339)
+		resp.ReassignedAt = &reassignedAt
+	}
+	return resp
+}
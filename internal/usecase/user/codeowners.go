@@ -0,0 +1,118 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"pr_reviewer_assignment_service/internal/dto"
+	"pr_reviewer_assignment_service/internal/dto/user"
+	"pr_reviewer_assignment_service/internal/entity"
+	"pr_reviewer_assignment_service/pkg/codeowners"
+
+	"go.uber.org/zap"
+)
+
+// ImportCodeowners разбирает CODEOWNERS-файл репозитория и заменяет им
+// импортированные ранее правила этого репозитория. @user сопоставляется с пользователем по имени, @org/team —
+// с командой по названию; прочие владельцы попадают в отчёт и игнорируются.
+// При DryRun правила не сохраняются.
+func (s *UserService) ImportCodeowners(ctx context.Context, req *user.ImportCodeownersRequest) (*user.ImportCodeownersResponse, error) {
+	s.logger.Info(ctx, "ImportCodeowners called",
+		zap.String("repository", req.Repository),
+		zap.Int("content_length", len(req.Content)),
+		zap.Bool("dry_run", req.DryRun),
+	)
+
+	parsed, invalidLines, err := codeowners.Parse(strings.NewReader(req.Content))
+	if err != nil {
+		s.logger.Warn(ctx, "Failed to parse CODEOWNERS", zap.Error(err))
+		return nil, dto.ErrInvalidCodeowners
+	}
+
+	var usernames, teamNames []string
+	for _, rule := range parsed {
+		for _, owner := range rule.Owners {
+			username, team, ok := codeowners.ParseOwner(owner)
+			if !ok {
+				continue
+			}
+			if team != "" {
+				teamNames = append(teamNames, team)
+			} else {
+				usernames = append(usernames, username)
+			}
+		}
+	}
+
+	userIDs, err := s.repo.GetIDsByUsernames(ctx, uniqueSorted(usernames))
+	if err != nil {
+		s.logger.Error(ctx, "Failed to resolve CODEOWNERS users", zap.Error(err))
+		return nil, err
+	}
+	knownTeams, err := s.repo.ExistingTeams(ctx, uniqueSorted(teamNames))
+	if err != nil {
+		s.logger.Error(ctx, "Failed to resolve CODEOWNERS teams", zap.Error(err))
+		return nil, err
+	}
+
+	var unknown, ambiguous []string
+	rules := make([]entity.CodeownersRule, 0, len(parsed))
+	for i, rule := range parsed {
+		resolved := entity.CodeownersRule{Position: i + 1, Line: rule.Line, Pattern: rule.Pattern}
+		for _, owner := range rule.Owners {
+			username, team, ok := codeowners.ParseOwner(owner)
+			switch {
+			case ok && team != "" && slices.Contains(knownTeams, team):
+				if !slices.Contains(resolved.Teams, team) {
+					resolved.Teams = append(resolved.Teams, team)
+				}
+			case ok && team == "" && len(userIDs[username]) == 1:
+				if !slices.Contains(resolved.UserIDs, userIDs[username][0]) {
+					resolved.UserIDs = append(resolved.UserIDs, userIDs[username][0])
+				}
+			case ok && team == "" && len(userIDs[username]) > 1:
+				ambiguous = append(ambiguous, owner)
+			default:
+				unknown = append(unknown, owner)
+			}
+		}
+		rules = append(rules, resolved)
+	}
+
+	resp := &user.ImportCodeownersResponse{
+		Repository:       req.Repository,
+		RulesImported:    len(rules),
+		DryRun:           req.DryRun,
+		InvalidLines:     invalidLines,
+		UnknownHandles:   uniqueSorted(unknown),
+		AmbiguousHandles: uniqueSorted(ambiguous),
+	}
+
+	if req.DryRun {
+		return resp, nil
+	}
+
+	if err := s.repo.ReplaceCodeownersRules(ctx, req.Repository, rules); err != nil {
+		s.logger.Error(ctx, "Failed to store CODEOWNERS rules", zap.Error(err))
+		return nil, err
+	}
+
+	s.logger.Info(ctx, "CODEOWNERS imported",
+		zap.String("repository", req.Repository),
+		zap.Int("rules", len(rules)),
+		zap.Int("invalid_lines", len(invalidLines)),
+		zap.Int("unknown_handles", len(resp.UnknownHandles)),
+	)
+	return resp, nil
+}
+
+func uniqueSorted(values []string) []string {
+	result := slices.Clone(values)
+	slices.Sort(result)
+	result = slices.Compact(result)
+	if result == nil {
+		result = []string{}
+	}
+	return result
+}
@@ -3,13 +3,35 @@ package usecase
 import (
 	"context"
 	"pr_reviewer_assignment_service/internal/entity"
+	"time"
 )
 
 type UserRepository interface {
 	GetByID(ctx context.Context, userID string) (*entity.User, error)
 	SetIsActive(ctx context.Context, userID string, isActive bool) (*entity.User, error)
+	Deactivate(ctx context.Context, userID string, plan []entity.Reassignment) (*entity.User, []entity.Reassignment, error)
+	SetMaxOpenReviews(ctx context.Context, userID string, limit *int) (*entity.User, error)
+	MoveUser(ctx context.Context, userID, toTeam string, plan []entity.Reassignment) (*entity.UserMove, []entity.Reassignment, error)
+	AddAbsence(ctx context.Context, absence *entity.Absence) (*entity.Absence, error)
+	GetAbsences(ctx context.Context, userID string) ([]entity.Absence, error)
+	DeleteAbsence(ctx context.Context, absenceID int64) error
+	GetStartedAbsences(ctx context.Context, now time.Time) ([]entity.Absence, error)
+	MarkAbsenceReassigned(ctx context.Context, absenceID int64, at time.Time, plan []entity.Reassignment) ([]entity.Reassignment, error)
+	SetOwnershipPatterns(ctx context.Context, userID string, patterns []string) error
+	GetOwnershipPatterns(ctx context.Context, userIDs []string) (map[string][]string, error)
+	GetIDsByUsernames(ctx context.Context, usernames []string) (map[string][]string, error)
+	ExistingTeams(ctx context.Context, names []string) ([]string, error)
+	ReplaceCodeownersRules(ctx context.Context, repository string, rules []entity.CodeownersRule) error
+	GetCodeownersRules(ctx context.Context, repository string) ([]entity.CodeownersRule, error)
 }
 
 type PRGetter interface {
 	GetByReviewer(ctx context.Context, userID string) ([]*entity.PullRequest, error)
 }
+
+// ReviewReassigner переназначает открытые ревью пользователя на других
+// участников команды. Реализуется PR-сервисом.
+type ReviewReassigner interface {
+	PlanTeamReassignments(ctx context.Context, userIDs []string, teamName string) ([]entity.Reassignment, error)
+	PlanReassignments(ctx context.Context, userIDs []string) ([]entity.Reassignment, error)
+}
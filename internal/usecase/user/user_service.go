@@ -2,26 +2,38 @@ package usecase
 
 import (
 	"context"
+	"slices"
+	"strings"
+	"time"
 
+	"pr_reviewer_assignment_service/internal/dto"
 	"pr_reviewer_assignment_service/internal/dto/pr"
 	"pr_reviewer_assignment_service/internal/dto/user"
+	"pr_reviewer_assignment_service/internal/entity"
+	"pr_reviewer_assignment_service/pkg/codeowners"
 	"pr_reviewer_assignment_service/pkg/logger"
 
 	"go.uber.org/zap"
 )
 
+const (
+	MoveKeepReviews      = "keep"
+	MoveRebalanceReviews = "rebalance"
+)
+
 type UserService struct {
-	repo   UserRepository
-	prRepo PRGetter
-	logger logger.Logger
+	repo       UserRepository
+	prRepo     PRGetter
+	reassigner ReviewReassigner
+	logger     logger.Logger
 }
 
 func (s *UserService) Logger() logger.Logger {
 	return s.logger
 }
 
-func NewUserService(repo UserRepository, prRepo PRGetter, logger logger.Logger) *UserService {
-	return &UserService{repo: repo, prRepo: prRepo, logger: logger}
+func NewUserService(repo UserRepository, prRepo PRGetter, reassigner ReviewReassigner, logger logger.Logger) *UserService {
+	return &UserService{repo: repo, prRepo: prRepo, reassigner: reassigner, logger: logger}
 }
 
 func (s *UserService) SetActive(ctx context.Context, req *user.SetIsActiveRequest) (*user.UserResponse, error) {
@@ -36,13 +48,126 @@ func (s *UserService) SetActive(ctx context.Context, req *user.SetIsActiveReques
 	s.logger.Info(ctx, "SetActive successful", zap.String("user_id", u.UserID), zap.Bool("is_active", u.IsActive))
 
 	return &user.UserResponse{
-		UserID:   u.UserID,
-		Username: u.Username,
-		TeamName: u.TeamName,
-		IsActive: u.IsActive,
+		UserID:         u.UserID,
+		Username:       u.Username,
+		TeamName:       u.TeamName,
+		IsActive:       u.IsActive,
+		MaxOpenReviews: u.MaxOpenReviews,
+	}, nil
+}
+
+// Deactivate выключает пользователя и в той же транзакции переназначает его
+// открытые ревью. PR, для которых замены не нашлось, остаются за ним и
+// попадают в ответ с пустым new_user_id.
+func (s *UserService) Deactivate(ctx context.Context, req *user.SetIsActiveRequest) (*user.DeactivateResponse, error) {
+	s.logger.Info(ctx, "Deactivate called", zap.String("user_id", req.UserID))
+
+	plan, err := s.reassigner.PlanReassignments(ctx, []string{req.UserID})
+	if err != nil {
+		s.logger.Error(ctx, "Failed to plan reassignments", zap.String("user_id", req.UserID), zap.Error(err))
+		return nil, err
+	}
+
+	u, applied, err := s.repo.Deactivate(ctx, req.UserID, plan)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to deactivate user", zap.String("user_id", req.UserID), zap.Error(err))
+		return nil, err
+	}
+
+	reassignments := make([]pr.ReassignmentDTO, 0, len(applied))
+	for _, r := range applied {
+		reassignments = append(reassignments, pr.ReassignmentDTO{
+			PullRequestID: r.PullRequestID,
+			OldUserID:     r.OldUserID,
+			NewUserID:     r.NewUserID,
+		})
+	}
+
+	s.logger.Info(ctx, "Deactivate successful", zap.String("user_id", u.UserID), zap.Int("reassignments", len(reassignments)))
+
+	return &user.DeactivateResponse{
+		User: user.UserResponse{
+			UserID:         u.UserID,
+			Username:       u.Username,
+			TeamName:       u.TeamName,
+			IsActive:       u.IsActive,
+			MaxOpenReviews: u.MaxOpenReviews,
+		},
+		Reassignments: reassignments,
 	}, nil
 }
 
+// SetMaxOpenReviews задаёт личный лимит открытых ревью пользователя; nil
+// возвращает его к лимиту команды.
+func (s *UserService) SetMaxOpenReviews(ctx context.Context, req *user.SetMaxOpenReviewsRequest) (*user.UserResponse, error) {
+	s.logger.Info(ctx, "SetMaxOpenReviews called", zap.String("user_id", req.UserID))
+
+	if req.MaxOpenReviews != nil && *req.MaxOpenReviews < 0 {
+		s.logger.Warn(ctx, "Invalid max_open_reviews", zap.String("user_id", req.UserID), zap.Int("max_open_reviews", *req.MaxOpenReviews))
+		return nil, dto.ErrInvalidCapacity
+	}
+
+	u, err := s.repo.SetMaxOpenReviews(ctx, req.UserID, req.MaxOpenReviews)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to set max open reviews", zap.String("user_id", req.UserID), zap.Error(err))
+		return nil, err
+	}
+
+	s.logger.Info(ctx, "SetMaxOpenReviews successful", zap.String("user_id", u.UserID))
+
+	return &user.UserResponse{
+		UserID:         u.UserID,
+		Username:       u.Username,
+		TeamName:       u.TeamName,
+		IsActive:       u.IsActive,
+		MaxOpenReviews: u.MaxOpenReviews,
+	}, nil
+}
+
+// SetOwnershipPatterns заменяет шаблоны владения пользователя. Пустой список
+// снимает с него владение всеми файлами.
+func (s *UserService) SetOwnershipPatterns(ctx context.Context, req *user.OwnershipPatternsDTO) (*user.OwnershipPatternsDTO, error) {
+	s.logger.Info(ctx, "SetOwnershipPatterns called", zap.String("user_id", req.UserID), zap.Int("patterns_count", len(req.Patterns)))
+
+	patterns := make([]string, 0, len(req.Patterns))
+	for _, raw := range req.Patterns {
+		pattern := strings.TrimSpace(raw)
+		if _, err := codeowners.Compile(pattern); err != nil || slices.Contains(patterns, pattern) {
+			s.logger.Warn(ctx, "Invalid ownership pattern", zap.String("user_id", req.UserID), zap.String("pattern", raw))
+			return nil, dto.ErrInvalidOwnership
+		}
+		patterns = append(patterns, pattern)
+	}
+
+	if err := s.repo.SetOwnershipPatterns(ctx, req.UserID, patterns); err != nil {
+		s.logger.Error(ctx, "Failed to set ownership patterns", zap.String("user_id", req.UserID), zap.Error(err))
+		return nil, err
+	}
+
+	return &user.OwnershipPatternsDTO{UserID: req.UserID, Patterns: patterns}, nil
+}
+
+func (s *UserService) GetOwnershipPatterns(ctx context.Context, userID string) (*user.OwnershipPatternsDTO, error) {
+	s.logger.Info(ctx, "GetOwnershipPatterns called", zap.String("user_id", userID))
+
+	if _, err := s.repo.GetByID(ctx, userID); err != nil {
+		s.logger.Error(ctx, "User not found", zap.String("user_id", userID), zap.Error(err))
+		return nil, err
+	}
+
+	patterns, err := s.repo.GetOwnershipPatterns(ctx, []string{userID})
+	if err != nil {
+		s.logger.Error(ctx, "Failed to get ownership patterns", zap.String("user_id", userID), zap.Error(err))
+		return nil, err
+	}
+
+	result := patterns[userID]
+	if result == nil {
+		result = []string{}
+	}
+	return &user.OwnershipPatternsDTO{UserID: userID, Patterns: result}, nil
+}
+
 func (s *UserService) GetReview(ctx context.Context, userID string) (*user.GetReviewResponse, error) {
 	s.logger.Info(ctx, "GetReview called", zap.String("user_id", userID))
 
@@ -69,3 +194,82 @@ func (s *UserService) GetReview(ctx context.Context, userID string) (*user.GetRe
 		PullRequests: shortList,
 	}, nil
 }
+
+// MoveUser переводит пользователя в другую команду. История ревью
+// сохраняется; открытые ревью остаются за пользователем (MoveKeepReviews)
+// или перераспределяются внутри старой команды (MoveRebalanceReviews) в той
+// же транзакции, что и перевод.
+func (s *UserService) MoveUser(ctx context.Context, req *user.MoveUserRequest) (*user.MoveUserResponse, error) {
+	s.logger.Info(ctx, "MoveUser called",
+		zap.String("user_id", req.UserID),
+		zap.String("team_name", req.TeamName),
+		zap.String("open_reviews", req.OpenReviews),
+	)
+
+	mode := req.OpenReviews
+	if mode == "" {
+		mode = MoveKeepReviews
+	}
+	if mode != MoveKeepReviews && mode != MoveRebalanceReviews {
+		s.logger.Warn(ctx, "Invalid open reviews mode", zap.String("open_reviews", req.OpenReviews))
+		return nil, dto.ErrInvalidMoveMode
+	}
+
+	var plan []entity.Reassignment
+	if mode == MoveRebalanceReviews {
+		current, err := s.repo.GetByID(ctx, req.UserID)
+		if err != nil {
+			s.logger.Error(ctx, "Failed to fetch user", zap.String("user_id", req.UserID), zap.Error(err))
+			return nil, err
+		}
+		if current.TeamName != "" && current.TeamName != req.TeamName {
+			plan, err = s.reassigner.PlanTeamReassignments(ctx, []string{req.UserID}, current.TeamName)
+			if err != nil {
+				s.logger.Error(ctx, "Failed to plan rebalance of open reviews", zap.String("user_id", req.UserID), zap.Error(err))
+				return nil, err
+			}
+		}
+	}
+
+	move, applied, err := s.repo.MoveUser(ctx, req.UserID, req.TeamName, plan)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to move user", zap.String("user_id", req.UserID), zap.Error(err))
+		return nil, err
+	}
+
+	reassignments := make([]pr.ReassignmentDTO, 0, len(applied))
+	for _, r := range applied {
+		reassignments = append(reassignments, pr.ReassignmentDTO{
+			PullRequestID: r.PullRequestID,
+			OldUserID:     r.OldUserID,
+			NewUserID:     r.NewUserID,
+			Released:      r.Released,
+		})
+	}
+
+	u, err := s.repo.GetByID(ctx, req.UserID)
+	if err != nil {
+		s.logger.Error(ctx, "Failed to fetch moved user", zap.String("user_id", req.UserID), zap.Error(err))
+		return nil, err
+	}
+
+	s.logger.Info(ctx, "MoveUser successful",
+		zap.String("user_id", req.UserID),
+		zap.String("from_team", move.FromTeam),
+		zap.String("to_team", move.ToTeam),
+		zap.Int("reassignments", len(reassignments)),
+	)
+
+	return &user.MoveUserResponse{
+		User: user.UserResponse{
+			UserID:         u.UserID,
+			Username:       u.Username,
+			TeamName:       u.TeamName,
+			IsActive:       u.IsActive,
+			MaxOpenReviews: u.MaxOpenReviews,
+		},
+		FromTeam:      move.FromTeam,
+		MovedAt:       move.MovedAt.UTC().Format(time.RFC3339),
+		Reassignments: reassignments,
+	}, nil
+}
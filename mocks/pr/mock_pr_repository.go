@@ -8,6 +8,7 @@ import (
 	context "context"
 	entity "pr_reviewer_assignment_service/internal/entity"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 )
@@ -35,6 +36,21 @@ func (m *MockPRRepository) EXPECT() *MockPRRepositoryMockRecorder {
 	return m.recorder
 }
 
+// CountOpenReviews mocks base method.
+func (m *MockPRRepository) CountOpenReviews(ctx context.Context, userIDs []string) (map[string]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountOpenReviews", ctx, userIDs)
+	ret0, _ := ret[0].(map[string]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountOpenReviews indicates an expected call of CountOpenReviews.
+func (mr *MockPRRepositoryMockRecorder) CountOpenReviews(ctx, userIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountOpenReviews", reflect.TypeOf((*MockPRRepository)(nil).CountOpenReviews), ctx, userIDs)
+}
+
 // Create mocks base method.
 func (m *MockPRRepository) Create(ctx context.Context, pr *entity.PullRequest) error {
 	m.ctrl.T.Helper()
@@ -79,18 +95,48 @@ func (mr *MockPRRepositoryMockRecorder) GetByReviewer(ctx, userID interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByReviewer", reflect.TypeOf((*MockPRRepository)(nil).GetByReviewer), ctx, userID)
 }
 
+// GetOpenByReviewers mocks base method.
+func (m *MockPRRepository) GetOpenByReviewers(ctx context.Context, userIDs []string) ([]*entity.PullRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOpenByReviewers", ctx, userIDs)
+	ret0, _ := ret[0].([]*entity.PullRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOpenByReviewers indicates an expected call of GetOpenByReviewers.
+func (mr *MockPRRepositoryMockRecorder) GetOpenByReviewers(ctx, userIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpenByReviewers", reflect.TypeOf((*MockPRRepository)(nil).GetOpenByReviewers), ctx, userIDs)
+}
+
+// List mocks base method.
+func (m *MockPRRepository) List(ctx context.Context, filter entity.PRListFilter) ([]*entity.PullRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, filter)
+	ret0, _ := ret[0].([]*entity.PullRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockPRRepositoryMockRecorder) List(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockPRRepository)(nil).List), ctx, filter)
+}
+
 // Merge mocks base method.
-func (m *MockPRRepository) Merge(ctx context.Context, prID string, pr *entity.PullRequest) error {
+func (m *MockPRRepository) Merge(ctx context.Context, prID string, pr *entity.PullRequest, check func([]entity.Review) error) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Merge", ctx, prID, pr)
+	ret := m.ctrl.Call(m, "Merge", ctx, prID, pr, check)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Merge indicates an expected call of Merge.
-func (mr *MockPRRepositoryMockRecorder) Merge(ctx, prID, pr interface{}) *gomock.Call {
+func (mr *MockPRRepositoryMockRecorder) Merge(ctx, prID, pr, check interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Merge", reflect.TypeOf((*MockPRRepository)(nil).Merge), ctx, prID, pr)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Merge", reflect.TypeOf((*MockPRRepository)(nil).Merge), ctx, prID, pr, check)
 }
 
 // ReassignReviewer mocks base method.
@@ -107,3 +153,31 @@ func (mr *MockPRRepositoryMockRecorder) ReassignReviewer(ctx, prID, oldUserID, n
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReassignReviewer", reflect.TypeOf((*MockPRRepository)(nil).ReassignReviewer), ctx, prID, oldUserID, newUserID)
 }
+
+// SubmitVerdict mocks base method.
+func (m *MockPRRepository) SubmitVerdict(ctx context.Context, prID, userID string, verdict entity.ReviewVerdict, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitVerdict", ctx, prID, userID, verdict, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SubmitVerdict indicates an expected call of SubmitVerdict.
+func (mr *MockPRRepositoryMockRecorder) SubmitVerdict(ctx, prID, userID, verdict, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitVerdict", reflect.TypeOf((*MockPRRepository)(nil).SubmitVerdict), ctx, prID, userID, verdict, at)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockPRRepository) UpdateStatus(ctx context.Context, pr *entity.PullRequest, from entity.PRStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, pr, from)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockPRRepositoryMockRecorder) UpdateStatus(ctx, pr, from interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockPRRepository)(nil).UpdateStatus), ctx, pr, from)
+}
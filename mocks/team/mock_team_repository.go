@@ -35,6 +35,36 @@ func (m *MockTeamRepository) EXPECT() *MockTeamRepositoryMockRecorder {
 	return m.recorder
 }
 
+// ActiveInOtherTeams mocks base method.
+func (m *MockTeamRepository) ActiveInOtherTeams(ctx context.Context, teamName string, userIDs []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActiveInOtherTeams", ctx, teamName, userIDs)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActiveInOtherTeams indicates an expected call of ActiveInOtherTeams.
+func (mr *MockTeamRepositoryMockRecorder) ActiveInOtherTeams(ctx, teamName, userIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActiveInOtherTeams", reflect.TypeOf((*MockTeamRepository)(nil).ActiveInOtherTeams), ctx, teamName, userIDs)
+}
+
+// ArchiveTeam mocks base method.
+func (m *MockTeamRepository) ArchiveTeam(ctx context.Context, teamName string, plan []entity.Reassignment) ([]entity.Reassignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveTeam", ctx, teamName, plan)
+	ret0, _ := ret[0].([]entity.Reassignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArchiveTeam indicates an expected call of ArchiveTeam.
+func (mr *MockTeamRepositoryMockRecorder) ArchiveTeam(ctx, teamName, plan interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveTeam", reflect.TypeOf((*MockTeamRepository)(nil).ArchiveTeam), ctx, teamName, plan)
+}
+
 // CreateTeam mocks base method.
 func (m *MockTeamRepository) CreateTeam(ctx context.Context, team *entity.Team) error {
 	m.ctrl.T.Helper()
@@ -49,6 +79,65 @@ func (mr *MockTeamRepositoryMockRecorder) CreateTeam(ctx, team interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTeam", reflect.TypeOf((*MockTeamRepository)(nil).CreateTeam), ctx, team)
 }
 
+// DeactivateMembers mocks base method.
+func (m *MockTeamRepository) DeactivateMembers(ctx context.Context, teamName string, userIDs []string, plan []entity.Reassignment) ([]entity.Reassignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeactivateMembers", ctx, teamName, userIDs, plan)
+	ret0, _ := ret[0].([]entity.Reassignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeactivateMembers indicates an expected call of DeactivateMembers.
+func (mr *MockTeamRepositoryMockRecorder) DeactivateMembers(ctx, teamName, userIDs, plan interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeactivateMembers", reflect.TypeOf((*MockTeamRepository)(nil).DeactivateMembers), ctx, teamName, userIDs, plan)
+}
+
+// DeleteTeam mocks base method.
+func (m *MockTeamRepository) DeleteTeam(ctx context.Context, teamName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTeam", ctx, teamName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTeam indicates an expected call of DeleteTeam.
+func (mr *MockTeamRepositoryMockRecorder) DeleteTeam(ctx, teamName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTeam", reflect.TypeOf((*MockTeamRepository)(nil).DeleteTeam), ctx, teamName)
+}
+
+// GetFallbackTeams mocks base method.
+func (m *MockTeamRepository) GetFallbackTeams(ctx context.Context, teamName string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFallbackTeams", ctx, teamName)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFallbackTeams indicates an expected call of GetFallbackTeams.
+func (mr *MockTeamRepositoryMockRecorder) GetFallbackTeams(ctx, teamName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFallbackTeams", reflect.TypeOf((*MockTeamRepository)(nil).GetFallbackTeams), ctx, teamName)
+}
+
+// GetMergePolicy mocks base method.
+func (m *MockTeamRepository) GetMergePolicy(ctx context.Context, teamName string) (*entity.MergePolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMergePolicy", ctx, teamName)
+	ret0, _ := ret[0].(*entity.MergePolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMergePolicy indicates an expected call of GetMergePolicy.
+func (mr *MockTeamRepositoryMockRecorder) GetMergePolicy(ctx, teamName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMergePolicy", reflect.TypeOf((*MockTeamRepository)(nil).GetMergePolicy), ctx, teamName)
+}
+
 // GetTeamByName mocks base method.
 func (m *MockTeamRepository) GetTeamByName(ctx context.Context, teamName string) (*entity.Team, error) {
 	m.ctrl.T.Helper()
@@ -63,3 +152,143 @@ func (mr *MockTeamRepositoryMockRecorder) GetTeamByName(ctx, teamName interface{
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamByName", reflect.TypeOf((*MockTeamRepository)(nil).GetTeamByName), ctx, teamName)
 }
+
+// GetTeamWithArchived mocks base method.
+func (m *MockTeamRepository) GetTeamWithArchived(ctx context.Context, teamName string) (*entity.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamWithArchived", ctx, teamName)
+	ret0, _ := ret[0].(*entity.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeamWithArchived indicates an expected call of GetTeamWithArchived.
+func (mr *MockTeamRepositoryMockRecorder) GetTeamWithArchived(ctx, teamName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamWithArchived", reflect.TypeOf((*MockTeamRepository)(nil).GetTeamWithArchived), ctx, teamName)
+}
+
+// SetFallbackTeams mocks base method.
+func (m *MockTeamRepository) SetFallbackTeams(ctx context.Context, teamName string, fallbacks []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFallbackTeams", ctx, teamName, fallbacks)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetFallbackTeams indicates an expected call of SetFallbackTeams.
+func (mr *MockTeamRepositoryMockRecorder) SetFallbackTeams(ctx, teamName, fallbacks interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFallbackTeams", reflect.TypeOf((*MockTeamRepository)(nil).SetFallbackTeams), ctx, teamName, fallbacks)
+}
+
+// SetMaxOpenReviews mocks base method.
+func (m *MockTeamRepository) SetMaxOpenReviews(ctx context.Context, teamName string, limit *int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMaxOpenReviews", ctx, teamName, limit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetMaxOpenReviews indicates an expected call of SetMaxOpenReviews.
+func (mr *MockTeamRepositoryMockRecorder) SetMaxOpenReviews(ctx, teamName, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMaxOpenReviews", reflect.TypeOf((*MockTeamRepository)(nil).SetMaxOpenReviews), ctx, teamName, limit)
+}
+
+// SetMergePolicy mocks base method.
+func (m *MockTeamRepository) SetMergePolicy(ctx context.Context, policy *entity.MergePolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMergePolicy", ctx, policy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetMergePolicy indicates an expected call of SetMergePolicy.
+func (mr *MockTeamRepositoryMockRecorder) SetMergePolicy(ctx, policy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMergePolicy", reflect.TypeOf((*MockTeamRepository)(nil).SetMergePolicy), ctx, policy)
+}
+
+// UpdateMembership mocks base method.
+func (m *MockTeamRepository) UpdateMembership(ctx context.Context, teamName, userID string, role *string, isActive *bool) (*entity.Membership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMembership", ctx, teamName, userID, role, isActive)
+	ret0, _ := ret[0].(*entity.Membership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMembership indicates an expected call of UpdateMembership.
+func (mr *MockTeamRepositoryMockRecorder) UpdateMembership(ctx, teamName, userID, role, isActive interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMembership", reflect.TypeOf((*MockTeamRepository)(nil).UpdateMembership), ctx, teamName, userID, role, isActive)
+}
+
+// UpdateTeam mocks base method.
+func (m *MockTeamRepository) UpdateTeam(ctx context.Context, update *entity.TeamUpdate, plan []entity.Reassignment) ([]entity.Reassignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTeam", ctx, update, plan)
+	ret0, _ := ret[0].([]entity.Reassignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTeam indicates an expected call of UpdateTeam.
+func (mr *MockTeamRepositoryMockRecorder) UpdateTeam(ctx, update, plan interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTeam", reflect.TypeOf((*MockTeamRepository)(nil).UpdateTeam), ctx, update, plan)
+}
+
+// MockReviewReassigner is a mock of ReviewReassigner interface.
+type MockReviewReassigner struct {
+	ctrl     *gomock.Controller
+	recorder *MockReviewReassignerMockRecorder
+}
+
+// MockReviewReassignerMockRecorder is the mock recorder for MockReviewReassigner.
+type MockReviewReassignerMockRecorder struct {
+	mock *MockReviewReassigner
+}
+
+// NewMockReviewReassigner creates a new mock instance.
+func NewMockReviewReassigner(ctrl *gomock.Controller) *MockReviewReassigner {
+	mock := &MockReviewReassigner{ctrl: ctrl}
+	mock.recorder = &MockReviewReassignerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReviewReassigner) EXPECT() *MockReviewReassignerMockRecorder {
+	return m.recorder
+}
+
+// PlanArchiveReassignments mocks base method.
+func (m *MockReviewReassigner) PlanArchiveReassignments(ctx context.Context, userIDs []string, teamName string, staying []string) ([]entity.Reassignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlanArchiveReassignments", ctx, userIDs, teamName, staying)
+	ret0, _ := ret[0].([]entity.Reassignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlanArchiveReassignments indicates an expected call of PlanArchiveReassignments.
+func (mr *MockReviewReassignerMockRecorder) PlanArchiveReassignments(ctx, userIDs, teamName, staying interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlanArchiveReassignments", reflect.TypeOf((*MockReviewReassigner)(nil).PlanArchiveReassignments), ctx, userIDs, teamName, staying)
+}
+
+// PlanTeamReassignments mocks base method.
+func (m *MockReviewReassigner) PlanTeamReassignments(ctx context.Context, userIDs []string, teamName string) ([]entity.Reassignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlanTeamReassignments", ctx, userIDs, teamName)
+	ret0, _ := ret[0].([]entity.Reassignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlanTeamReassignments indicates an expected call of PlanTeamReassignments.
+func (mr *MockReviewReassignerMockRecorder) PlanTeamReassignments(ctx, userIDs, teamName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlanTeamReassignments", reflect.TypeOf((*MockReviewReassigner)(nil).PlanTeamReassignments), ctx, userIDs, teamName)
+}
@@ -8,6 +8,7 @@ import (
 	context "context"
 	entity "pr_reviewer_assignment_service/internal/entity"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 )
@@ -35,6 +36,81 @@ func (m *MockUserRepository) EXPECT() *MockUserRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AddAbsence mocks base method.
+func (m *MockUserRepository) AddAbsence(ctx context.Context, absence *entity.Absence) (*entity.Absence, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAbsence", ctx, absence)
+	ret0, _ := ret[0].(*entity.Absence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddAbsence indicates an expected call of AddAbsence.
+func (mr *MockUserRepositoryMockRecorder) AddAbsence(ctx, absence interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAbsence", reflect.TypeOf((*MockUserRepository)(nil).AddAbsence), ctx, absence)
+}
+
+// Deactivate mocks base method.
+func (m *MockUserRepository) Deactivate(ctx context.Context, userID string, plan []entity.Reassignment) (*entity.User, []entity.Reassignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deactivate", ctx, userID, plan)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].([]entity.Reassignment)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Deactivate indicates an expected call of Deactivate.
+func (mr *MockUserRepositoryMockRecorder) Deactivate(ctx, userID, plan interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deactivate", reflect.TypeOf((*MockUserRepository)(nil).Deactivate), ctx, userID, plan)
+}
+
+// DeleteAbsence mocks base method.
+func (m *MockUserRepository) DeleteAbsence(ctx context.Context, absenceID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAbsence", ctx, absenceID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAbsence indicates an expected call of DeleteAbsence.
+func (mr *MockUserRepositoryMockRecorder) DeleteAbsence(ctx, absenceID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAbsence", reflect.TypeOf((*MockUserRepository)(nil).DeleteAbsence), ctx, absenceID)
+}
+
+// ExistingTeams mocks base method.
+func (m *MockUserRepository) ExistingTeams(ctx context.Context, names []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExistingTeams", ctx, names)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExistingTeams indicates an expected call of ExistingTeams.
+func (mr *MockUserRepositoryMockRecorder) ExistingTeams(ctx, names interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExistingTeams", reflect.TypeOf((*MockUserRepository)(nil).ExistingTeams), ctx, names)
+}
+
+// GetAbsences mocks base method.
+func (m *MockUserRepository) GetAbsences(ctx context.Context, userID string) ([]entity.Absence, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAbsences", ctx, userID)
+	ret0, _ := ret[0].([]entity.Absence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAbsences indicates an expected call of GetAbsences.
+func (mr *MockUserRepositoryMockRecorder) GetAbsences(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAbsences", reflect.TypeOf((*MockUserRepository)(nil).GetAbsences), ctx, userID)
+}
+
 // GetByID mocks base method.
 func (m *MockUserRepository) GetByID(ctx context.Context, userID string) (*entity.User, error) {
 	m.ctrl.T.Helper()
@@ -50,6 +126,111 @@ func (mr *MockUserRepositoryMockRecorder) GetByID(ctx, userID interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockUserRepository)(nil).GetByID), ctx, userID)
 }
 
+// GetCodeownersRules mocks base method.
+func (m *MockUserRepository) GetCodeownersRules(ctx context.Context, repository string) ([]entity.CodeownersRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCodeownersRules", ctx, repository)
+	ret0, _ := ret[0].([]entity.CodeownersRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCodeownersRules indicates an expected call of GetCodeownersRules.
+func (mr *MockUserRepositoryMockRecorder) GetCodeownersRules(ctx, repository interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCodeownersRules", reflect.TypeOf((*MockUserRepository)(nil).GetCodeownersRules), ctx, repository)
+}
+
+// GetIDsByUsernames mocks base method.
+func (m *MockUserRepository) GetIDsByUsernames(ctx context.Context, usernames []string) (map[string][]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIDsByUsernames", ctx, usernames)
+	ret0, _ := ret[0].(map[string][]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIDsByUsernames indicates an expected call of GetIDsByUsernames.
+func (mr *MockUserRepositoryMockRecorder) GetIDsByUsernames(ctx, usernames interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIDsByUsernames", reflect.TypeOf((*MockUserRepository)(nil).GetIDsByUsernames), ctx, usernames)
+}
+
+// GetOwnershipPatterns mocks base method.
+func (m *MockUserRepository) GetOwnershipPatterns(ctx context.Context, userIDs []string) (map[string][]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOwnershipPatterns", ctx, userIDs)
+	ret0, _ := ret[0].(map[string][]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOwnershipPatterns indicates an expected call of GetOwnershipPatterns.
+func (mr *MockUserRepositoryMockRecorder) GetOwnershipPatterns(ctx, userIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOwnershipPatterns", reflect.TypeOf((*MockUserRepository)(nil).GetOwnershipPatterns), ctx, userIDs)
+}
+
+// GetStartedAbsences mocks base method.
+func (m *MockUserRepository) GetStartedAbsences(ctx context.Context, now time.Time) ([]entity.Absence, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStartedAbsences", ctx, now)
+	ret0, _ := ret[0].([]entity.Absence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStartedAbsences indicates an expected call of GetStartedAbsences.
+func (mr *MockUserRepositoryMockRecorder) GetStartedAbsences(ctx, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStartedAbsences", reflect.TypeOf((*MockUserRepository)(nil).GetStartedAbsences), ctx, now)
+}
+
+// MarkAbsenceReassigned mocks base method.
+func (m *MockUserRepository) MarkAbsenceReassigned(ctx context.Context, absenceID int64, at time.Time, plan []entity.Reassignment) ([]entity.Reassignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAbsenceReassigned", ctx, absenceID, at, plan)
+	ret0, _ := ret[0].([]entity.Reassignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkAbsenceReassigned indicates an expected call of MarkAbsenceReassigned.
+func (mr *MockUserRepositoryMockRecorder) MarkAbsenceReassigned(ctx, absenceID, at, plan interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAbsenceReassigned", reflect.TypeOf((*MockUserRepository)(nil).MarkAbsenceReassigned), ctx, absenceID, at, plan)
+}
+
+// MoveUser mocks base method.
+func (m *MockUserRepository) MoveUser(ctx context.Context, userID, toTeam string, plan []entity.Reassignment) (*entity.UserMove, []entity.Reassignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveUser", ctx, userID, toTeam, plan)
+	ret0, _ := ret[0].(*entity.UserMove)
+	ret1, _ := ret[1].([]entity.Reassignment)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// MoveUser indicates an expected call of MoveUser.
+func (mr *MockUserRepositoryMockRecorder) MoveUser(ctx, userID, toTeam, plan interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveUser", reflect.TypeOf((*MockUserRepository)(nil).MoveUser), ctx, userID, toTeam, plan)
+}
+
+// ReplaceCodeownersRules mocks base method.
+func (m *MockUserRepository) ReplaceCodeownersRules(ctx context.Context, repository string, rules []entity.CodeownersRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceCodeownersRules", ctx, repository, rules)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceCodeownersRules indicates an expected call of ReplaceCodeownersRules.
+func (mr *MockUserRepositoryMockRecorder) ReplaceCodeownersRules(ctx, repository, rules interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceCodeownersRules", reflect.TypeOf((*MockUserRepository)(nil).ReplaceCodeownersRules), ctx, repository, rules)
+}
+
 // SetIsActive mocks base method.
 func (m *MockUserRepository) SetIsActive(ctx context.Context, userID string, isActive bool) (*entity.User, error) {
 	m.ctrl.T.Helper()
@@ -65,6 +246,35 @@ func (mr *MockUserRepositoryMockRecorder) SetIsActive(ctx, userID, isActive inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIsActive", reflect.TypeOf((*MockUserRepository)(nil).SetIsActive), ctx, userID, isActive)
 }
 
+// SetMaxOpenReviews mocks base method.
+func (m *MockUserRepository) SetMaxOpenReviews(ctx context.Context, userID string, limit *int) (*entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMaxOpenReviews", ctx, userID, limit)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetMaxOpenReviews indicates an expected call of SetMaxOpenReviews.
+func (mr *MockUserRepositoryMockRecorder) SetMaxOpenReviews(ctx, userID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMaxOpenReviews", reflect.TypeOf((*MockUserRepository)(nil).SetMaxOpenReviews), ctx, userID, limit)
+}
+
+// SetOwnershipPatterns mocks base method.
+func (m *MockUserRepository) SetOwnershipPatterns(ctx context.Context, userID string, patterns []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetOwnershipPatterns", ctx, userID, patterns)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetOwnershipPatterns indicates an expected call of SetOwnershipPatterns.
+func (mr *MockUserRepositoryMockRecorder) SetOwnershipPatterns(ctx, userID, patterns interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOwnershipPatterns", reflect.TypeOf((*MockUserRepository)(nil).SetOwnershipPatterns), ctx, userID, patterns)
+}
+
 // MockPRGetter is a mock of PRGetter interface.
 type MockPRGetter struct {
 	ctrl     *gomock.Controller
@@ -102,3 +312,56 @@ func (mr *MockPRGetterMockRecorder) GetByReviewer(ctx, userID interface{}) *gomo
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByReviewer", reflect.TypeOf((*MockPRGetter)(nil).GetByReviewer), ctx, userID)
 }
+
+// MockReviewReassigner is a mock of ReviewReassigner interface.
+type MockReviewReassigner struct {
+	ctrl     *gomock.Controller
+	recorder *MockReviewReassignerMockRecorder
+}
+
+// MockReviewReassignerMockRecorder is the mock recorder for MockReviewReassigner.
+type MockReviewReassignerMockRecorder struct {
+	mock *MockReviewReassigner
+}
+
+// NewMockReviewReassigner creates a new mock instance.
+func NewMockReviewReassigner(ctrl *gomock.Controller) *MockReviewReassigner {
+	mock := &MockReviewReassigner{ctrl: ctrl}
+	mock.recorder = &MockReviewReassignerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReviewReassigner) EXPECT() *MockReviewReassignerMockRecorder {
+	return m.recorder
+}
+
+// PlanReassignments mocks base method.
+func (m *MockReviewReassigner) PlanReassignments(ctx context.Context, userIDs []string) ([]entity.Reassignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlanReassignments", ctx, userIDs)
+	ret0, _ := ret[0].([]entity.Reassignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlanReassignments indicates an expected call of PlanReassignments.
+func (mr *MockReviewReassignerMockRecorder) PlanReassignments(ctx, userIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlanReassignments", reflect.TypeOf((*MockReviewReassigner)(nil).PlanReassignments), ctx, userIDs)
+}
+
+// PlanTeamReassignments mocks base method.
+func (m *MockReviewReassigner) PlanTeamReassignments(ctx context.Context, userIDs []string, teamName string) ([]entity.Reassignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlanTeamReassignments", ctx, userIDs, teamName)
+	ret0, _ := ret[0].([]entity.Reassignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlanTeamReassignments indicates an expected call of PlanTeamReassignments.
+func (mr *MockReviewReassignerMockRecorder) PlanTeamReassignments(ctx, userIDs, teamName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlanTeamReassignments", reflect.TypeOf((*MockReviewReassigner)(nil).PlanTeamReassignments), ctx, userIDs, teamName)
+}
@@ -0,0 +1,71 @@
+package codeowners
+
+import (
+	"bufio"
+	"io"
+	"strings"
+)
+
+// Rule — строка CODEOWNERS-файла: шаблон и владельцы в исходном виде
+// (@user, @org/team или email). Правило без владельцев снимает владение с
+// подходящих файлов.
+type Rule struct {
+	Line    int
+	Pattern string
+	Owners  []string
+}
+
+// Parse разбирает CODEOWNERS-файл. Как и GitHub, строки с некорректным
+// шаблоном не прерывают разбор: они пропускаются, а их номера возвращаются
+// во втором значении.
+func Parse(r io.Reader) ([]Rule, []int, error) {
+	rules := []Rule{}
+	invalid := []int{}
+
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+
+		fields := strings.Fields(scanner.Text())
+		for i, field := range fields {
+			if strings.HasPrefix(field, "#") {
+				fields = fields[:i]
+				break
+			}
+		}
+		if len(fields) == 0 {
+			continue
+		}
+
+		if _, err := Compile(fields[0]); err != nil {
+			invalid = append(invalid, line)
+			continue
+		}
+
+		rules = append(rules, Rule{Line: line, Pattern: fields[0], Owners: fields[1:]})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	return rules, invalid, nil
+}
+
+// ParseOwner разбирает владельца правила: для "@user" возвращает user, для
+// "@org/team" — team. Email-адреса и прочие записи не распознаются.
+func ParseOwner(owner string) (user, team string, ok bool) {
+	handle, found := strings.CutPrefix(owner, "@")
+	if !found || handle == "" {
+		return "", "", false
+	}
+
+	if org, name, isTeam := strings.Cut(handle, "/"); isTeam {
+		if org == "" || name == "" || strings.Contains(name, "/") {
+			return "", "", false
+		}
+		return "", name, true
+	}
+
+	return handle, "", true
+}
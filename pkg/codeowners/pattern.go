@@ -0,0 +1,101 @@
+// Package codeowners реализует сопоставление путей файлов с шаблонами в
+// стиле CODEOWNERS.
+package codeowners
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+var ErrInvalidPattern = errors.New("invalid ownership pattern")
+
+// Pattern — скомпилированный шаблон владения.
+//
+// Правила те же, что у CODEOWNERS: шаблон без "/" совпадает с файлом или
+// каталогом на любой глубине, шаблон с "/" отсчитывается от корня
+// репозитория, "*" и "?" не пересекают "/", "**" пересекает. Совпадение с
+// каталогом означает совпадение со всем его содержимым (кроме шаблонов,
+// последний сегмент которых содержит "*"), а завершающий "/" ограничивает
+// шаблон только каталогами.
+type Pattern struct {
+	raw string
+	re  *regexp.Regexp
+}
+
+func Compile(pattern string) (*Pattern, error) {
+	p := strings.TrimSpace(pattern)
+	if p == "" || p == "/" || strings.HasPrefix(p, "#") || strings.ContainsAny(p, " \t\\[]") {
+		return nil, ErrInvalidPattern
+	}
+
+	dirOnly := strings.HasSuffix(p, "/")
+	p = strings.TrimSuffix(p, "/")
+
+	anchored := strings.Contains(p, "/")
+	p = strings.TrimPrefix(p, "/")
+
+	var b strings.Builder
+	b.WriteString("^")
+	if !anchored {
+		b.WriteString("(?:.*/)?")
+	}
+	for i := 0; i < len(p); i++ {
+		switch {
+		case strings.HasPrefix(p[i:], "**/"):
+			b.WriteString("(?:.*/)?")
+			i += 2
+		case strings.HasPrefix(p[i:], "**"):
+			b.WriteString(".*")
+			i++
+		case p[i] == '*':
+			b.WriteString("[^/]*")
+		case p[i] == '?':
+			b.WriteString("[^/]")
+		default:
+			b.WriteString(regexp.QuoteMeta(p[i : i+1]))
+		}
+	}
+	switch {
+	case dirOnly:
+		b.WriteString("/.*$")
+	case strings.Contains(p[strings.LastIndex(p, "/")+1:], "*"):
+		// "docs/*" покрывает только файлы верхнего уровня каталога docs.
+		b.WriteString("$")
+	default:
+		b.WriteString("(?:/.*)?$")
+	}
+
+	re, err := regexp.Compile(b.String())
+	if err != nil {
+		return nil, ErrInvalidPattern
+	}
+	return &Pattern{raw: pattern, re: re}, nil
+}
+
+func (p *Pattern) String() string {
+	return p.raw
+}
+
+// Match сообщает, покрывает ли шаблон путь файла относительно корня
+// репозитория.
+func (p *Pattern) Match(path string) bool {
+	return p.re.MatchString(strings.TrimPrefix(path, "/"))
+}
+
+// MatchAny сообщает, покрывает ли хотя бы один из шаблонов хотя бы один из
+// путей. Некорректные шаблоны пропускаются.
+func MatchAny(patterns, paths []string) bool {
+	for _, raw := range patterns {
+		p, err := Compile(raw)
+		if err != nil {
+			continue
+		}
+		for _, path := range paths {
+			if p.Match(path) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,53 @@
+package codeowners_test
+
+import (
+	"strings"
+	"testing"
+
+	"pr_reviewer_assignment_service/pkg/codeowners"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	content := `# Default owners
+*       @acme/platform
+
+/internal/billing/  @alice @acme/payments  # billing
+docs/[a-z]*.md      @bob
+*.sql               dba@example.com
+/vendor/
+`
+
+	rules, invalid, err := codeowners.Parse(strings.NewReader(content))
+	require.NoError(t, err)
+	require.Equal(t, []int{5}, invalid)
+	require.Equal(t, []codeowners.Rule{
+		{Line: 2, Pattern: "*", Owners: []string{"@acme/platform"}},
+		{Line: 4, Pattern: "/internal/billing/", Owners: []string{"@alice", "@acme/payments"}},
+		{Line: 6, Pattern: "*.sql", Owners: []string{"dba@example.com"}},
+		{Line: 7, Pattern: "/vendor/", Owners: []string{}},
+	}, rules)
+}
+
+func TestParseOwner(t *testing.T) {
+	tests := []struct {
+		owner    string
+		wantUser string
+		wantTeam string
+		wantOK   bool
+	}{
+		{"@alice", "alice", "", true},
+		{"@acme/payments", "", "payments", true},
+		{"@acme/", "", "", false},
+		{"@", "", "", false},
+		{"dba@example.com", "", "", false},
+	}
+
+	for _, tt := range tests {
+		user, team, ok := codeowners.ParseOwner(tt.owner)
+		require.Equal(t, tt.wantOK, ok, tt.owner)
+		require.Equal(t, tt.wantUser, user, tt.owner)
+		require.Equal(t, tt.wantTeam, team, tt.owner)
+	}
+}
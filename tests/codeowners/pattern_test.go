@@ -0,0 +1,51 @@
+package codeowners_test
+
+import (
+	"testing"
+
+	"pr_reviewer_assignment_service/pkg/codeowners"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPattern_Match(t *testing.T) {
+	tests := []struct {
+		pattern string
+		path    string
+		want    bool
+	}{
+		{"*", "any/file.txt", true},
+		{"*.go", "cmd/api/main.go", true},
+		{"*.go", "main.go.bak", false},
+		{"apps/", "apps/web/index.ts", true},
+		{"apps/", "services/apps/job.py", true},
+		{"apps/", "apps", false},
+		{"/build/logs/", "build/logs/out.log", true},
+		{"/build/logs/", "src/build/logs/out.log", false},
+		{"docs/*", "docs/intro.md", true},
+		{"docs/*", "docs/guides/setup.md", false},
+		{"docs", "docs/guides/setup.md", true},
+		{"**/logs", "deploy/logs/app.log", true},
+		{"internal/**/repository", "internal/a/b/repository/user.go", true},
+		{"internal/**/repository", "pkg/repository/user.go", false},
+		{"/README.md", "README.md", true},
+		{"/README.md", "docs/README.md", false},
+		{"file?.txt", "dir/file1.txt", true},
+		{"file?.txt", "dir/file10.txt", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern+" "+tt.path, func(t *testing.T) {
+			p, err := codeowners.Compile(tt.pattern)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, p.Match(tt.path))
+		})
+	}
+}
+
+func TestCompile_Invalid(t *testing.T) {
+	for _, pattern := range []string{"", "  ", "/", "# comment", "src/[ab].go", "two words"} {
+		_, err := codeowners.Compile(pattern)
+		require.ErrorIs(t, err, codeowners.ErrInvalidPattern, pattern)
+	}
+}
@@ -0,0 +1,156 @@
+package pr_test
+
+import (
+	"context"
+	"testing"
+
+	"pr_reviewer_assignment_service/internal/dto"
+	dtoPR "pr_reviewer_assignment_service/internal/dto/pr"
+	entity "pr_reviewer_assignment_service/internal/entity"
+	usecasePr "pr_reviewer_assignment_service/internal/usecase/pr"
+	mockLogger "pr_reviewer_assignment_service/mocks/logger"
+	mockPR "pr_reviewer_assignment_service/mocks/pr"
+	mockTeam "pr_reviewer_assignment_service/mocks/team"
+	mockUser "pr_reviewer_assignment_service/mocks/user"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const capacityPRID = "5d1c9a7e-3b2f-4e61-8c0a-7f9b2e4d6a13"
+
+func intPtr(v int) *int {
+	return &v
+}
+
+func capacityTeam() *entity.Team {
+	return &entity.Team{
+		TeamName:       "Backend",
+		MaxOpenReviews: intPtr(3),
+		Members: []entity.User{
+			{UserID: "author", IsActive: true},
+			{UserID: "u1", IsActive: true},
+			{UserID: "u2", IsActive: true, MaxOpenReviews: intPtr(1)},
+			{UserID: "u3", IsActive: true},
+		},
+	}
+}
+
+func TestCreatePR_SkipsReviewersAtCapacity(t *testing.T) {
+	tests := []struct {
+		name             string
+		loads            map[string]int
+		wantReviewers    []string
+		wantUnderStaffed bool
+	}{
+		{
+			name:          "everyone has room",
+			loads:         map[string]int{"u1": 2},
+			wantReviewers: []string{"u1", "u2"},
+		},
+		{
+			name:          "personal limit overrides team default",
+			loads:         map[string]int{"u1": 2, "u2": 1},
+			wantReviewers: []string{"u1", "u3"},
+		},
+		{
+			name:             "not enough room",
+			loads:            map[string]int{"u1": 3, "u2": 1},
+			wantReviewers:    []string{"u3"},
+			wantUnderStaffed: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			ctrl := gomock.NewController(t)
+
+			repo := mockPR.NewMockPRRepository(ctrl)
+			teamRepo := mockTeam.NewMockTeamRepository(ctrl)
+			userRepo := mockUser.NewMockUserRepository(ctrl)
+			logger := mockLogger.NewMockLogger()
+
+			svc := usecasePr.NewPRService(repo, teamRepo, userRepo, usecasePr.NewRoundRobinSelector(), usecasePr.Config{MaxReviewers: 2}, logger)
+
+			repo.EXPECT().GetByID(ctx, capacityPRID).Return(nil, dto.ErrNotFound)
+			userRepo.EXPECT().GetByID(ctx, "author").Return(&entity.User{UserID: "author", TeamName: "Backend", IsActive: true}, nil)
+			teamRepo.EXPECT().GetTeamByName(ctx, "Backend").Return(capacityTeam(), nil)
+			repo.EXPECT().CountOpenReviews(ctx, []string{"u1", "u2", "u3"}).Return(tt.loads, nil)
+			teamRepo.EXPECT().GetFallbackTeams(ctx, "Backend").Return(nil, nil).MaxTimes(1)
+			repo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, p *entity.PullRequest) error {
+				require.Equal(t, tt.wantUnderStaffed, p.UnderStaffed)
+				return nil
+			})
+
+			resp, err := svc.CreatePR(ctx, &dtoPR.CreatePRRequest{
+				PullRequestID:   capacityPRID,
+				PullRequestName: "Capacity",
+				AuthorID:        "author",
+			})
+
+			require.NoError(t, err)
+			require.Equal(t, tt.wantReviewers, resp.AssignedReviewers)
+			require.Equal(t, tt.wantUnderStaffed, resp.UnderStaffed)
+		})
+	}
+}
+
+func TestCreatePR_ExplicitCountAtCapacity(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	repo := mockPR.NewMockPRRepository(ctrl)
+	teamRepo := mockTeam.NewMockTeamRepository(ctrl)
+	userRepo := mockUser.NewMockUserRepository(ctrl)
+	logger := mockLogger.NewMockLogger()
+
+	svc := usecasePr.NewPRService(repo, teamRepo, userRepo, usecasePr.NewRoundRobinSelector(), usecasePr.Config{MaxReviewers: 2}, logger)
+
+	repo.EXPECT().GetByID(ctx, capacityPRID).Return(nil, dto.ErrNotFound)
+	userRepo.EXPECT().GetByID(ctx, "author").Return(&entity.User{UserID: "author", TeamName: "Backend", IsActive: true}, nil)
+	teamRepo.EXPECT().GetTeamByName(ctx, "Backend").Return(capacityTeam(), nil)
+	repo.EXPECT().CountOpenReviews(ctx, []string{"u1", "u2", "u3"}).Return(map[string]int{"u1": 3, "u2": 1}, nil)
+	teamRepo.EXPECT().GetFallbackTeams(ctx, "Backend").Return(nil, nil)
+
+	resp, err := svc.CreatePR(ctx, &dtoPR.CreatePRRequest{
+		PullRequestID:   capacityPRID,
+		PullRequestName: "Capacity",
+		AuthorID:        "author",
+		ReviewersCount:  intPtr(2),
+	})
+
+	require.Nil(t, resp)
+	require.ErrorIs(t, err, dto.ErrNotEnoughReviewers)
+}
+
+func TestReassignReviewer_AllCandidatesAtCapacity(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	repo := mockPR.NewMockPRRepository(ctrl)
+	teamRepo := mockTeam.NewMockTeamRepository(ctrl)
+	userRepo := mockUser.NewMockUserRepository(ctrl)
+	logger := mockLogger.NewMockLogger()
+
+	svc := usecasePr.NewPRService(repo, teamRepo, userRepo, nil, usecasePr.Config{MaxReviewers: 2}, logger)
+
+	repo.EXPECT().GetByID(ctx, capacityPRID).Return(&entity.PullRequest{
+		PullRequestID:     capacityPRID,
+		AuthorID:          "author",
+		TeamName:          "Backend",
+		Status:            entity.StatusOpen,
+		AssignedReviewers: []string{"u1"},
+	}, nil)
+	teamRepo.EXPECT().GetTeamByName(ctx, "Backend").Return(capacityTeam(), nil)
+	repo.EXPECT().CountOpenReviews(ctx, []string{"u2", "u3"}).Return(map[string]int{"u2": 1, "u3": 3}, nil)
+
+	resp, replacedBy, err := svc.ReassignReviewer(ctx, &dtoPR.ReassignRequest{
+		PullRequestID: capacityPRID,
+		OldUserID:     "u1",
+	})
+
+	require.Nil(t, resp)
+	require.Empty(t, replacedBy)
+	require.ErrorIs(t, err, dto.ErrNoCandidate)
+}
@@ -0,0 +1,174 @@
+package pr_test
+
+import (
+	"context"
+	"testing"
+
+	"pr_reviewer_assignment_service/internal/dto"
+	dtoPR "pr_reviewer_assignment_service/internal/dto/pr"
+	entity "pr_reviewer_assignment_service/internal/entity"
+	usecasePr "pr_reviewer_assignment_service/internal/usecase/pr"
+	mockLogger "pr_reviewer_assignment_service/mocks/logger"
+	mockPR "pr_reviewer_assignment_service/mocks/pr"
+	mockTeam "pr_reviewer_assignment_service/mocks/team"
+	mockUser "pr_reviewer_assignment_service/mocks/user"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const expertisePRID = "8e2b4f61-0c7d-4a95-b3e8-1d6f9a2c5b70"
+
+func TestCreatePR_PrefersFileOwners(t *testing.T) {
+	tests := []struct {
+		name          string
+		files         []string
+		patterns      map[string][]string
+		rules         []entity.CodeownersRule
+		wantFiles     []string
+		wantReviewers []string
+	}{
+		{
+			name:          "owner goes first",
+			files:         []string{"/internal/billing/invoice.go", "internal/billing/invoice.go"},
+			patterns:      map[string][]string{"u3": {"internal/billing/"}, "u4": {"docs/"}},
+			wantFiles:     []string{"internal/billing/invoice.go"},
+			wantReviewers: []string{"u3", "u4"},
+		},
+		{
+			name:          "enough owners",
+			files:         []string{"internal/billing/invoice.go"},
+			patterns:      map[string][]string{"u2": {"*.go"}, "u4": {"/internal/"}},
+			wantFiles:     []string{"internal/billing/invoice.go"},
+			wantReviewers: []string{"u2", "u4"},
+		},
+		{
+			name:          "nobody matches",
+			files:         []string{"README.md"},
+			patterns:      map[string][]string{"u3": {"internal/billing/"}},
+			wantFiles:     []string{"README.md"},
+			wantReviewers: []string{"u1", "u2"},
+		},
+		{
+			name:  "codeowners last match wins",
+			files: []string{"internal/billing/invoice.go"},
+			rules: []entity.CodeownersRule{
+				{Position: 1, Pattern: "*.go", UserIDs: []string{"u2"}},
+				{Position: 2, Pattern: "/internal/billing/", UserIDs: []string{"u4"}},
+			},
+			wantFiles:     []string{"internal/billing/invoice.go"},
+			wantReviewers: []string{"u4", "u1"},
+		},
+		{
+			name:  "codeowners rule without owners",
+			files: []string{"internal/billing/invoice.go"},
+			rules: []entity.CodeownersRule{
+				{Position: 1, Pattern: "*.go", UserIDs: []string{"u3"}},
+				{Position: 2, Pattern: "/internal/billing/"},
+			},
+			wantFiles:     []string{"internal/billing/invoice.go"},
+			wantReviewers: []string{"u1", "u2"},
+		},
+		{
+			name:          "no files",
+			wantReviewers: []string{"u1", "u2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			ctrl := gomock.NewController(t)
+
+			repo := mockPR.NewMockPRRepository(ctrl)
+			teamRepo := mockTeam.NewMockTeamRepository(ctrl)
+			userRepo := mockUser.NewMockUserRepository(ctrl)
+			logger := mockLogger.NewMockLogger()
+
+			svc := usecasePr.NewPRService(repo, teamRepo, userRepo, usecasePr.NewRoundRobinSelector(), usecasePr.Config{MaxReviewers: 2}, logger)
+
+			repo.EXPECT().GetByID(ctx, expertisePRID).Return(nil, dto.ErrNotFound)
+			userRepo.EXPECT().GetByID(ctx, "author").Return(&entity.User{UserID: "author", TeamName: "Backend", IsActive: true}, nil)
+			teamRepo.EXPECT().GetTeamByName(ctx, "Backend").Return(&entity.Team{
+				TeamName: "Backend",
+				Members: []entity.User{
+					{UserID: "author", IsActive: true},
+					{UserID: "u1", IsActive: true},
+					{UserID: "u2", IsActive: true},
+					{UserID: "u3", IsActive: true},
+					{UserID: "u4", IsActive: true},
+				},
+			}, nil)
+			if tt.files != nil {
+				userRepo.EXPECT().GetOwnershipPatterns(ctx, []string{"u1", "u2", "u3", "u4"}).Return(tt.patterns, nil)
+				userRepo.EXPECT().GetCodeownersRules(ctx, "acme/api").Return(tt.rules, nil)
+			}
+			repo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, p *entity.PullRequest) error {
+				require.Equal(t, tt.wantFiles, p.ChangedFiles)
+				require.Equal(t, "acme/api", p.Repository)
+				return nil
+			})
+
+			resp, err := svc.CreatePR(ctx, &dtoPR.CreatePRRequest{
+				PullRequestID:   expertisePRID,
+				PullRequestName: "Expertise",
+				AuthorID:        "author",
+				Repository:      "acme/api",
+				ChangedFiles:    tt.files,
+			})
+
+			require.NoError(t, err)
+			require.Equal(t, tt.wantReviewers, resp.AssignedReviewers)
+		})
+	}
+}
+
+func TestCreatePR_CodeownersLoadedOncePerPR(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	repo := mockPR.NewMockPRRepository(ctrl)
+	teamRepo := mockTeam.NewMockTeamRepository(ctrl)
+	userRepo := mockUser.NewMockUserRepository(ctrl)
+	logger := mockLogger.NewMockLogger()
+
+	svc := usecasePr.NewPRService(repo, teamRepo, userRepo, usecasePr.NewRoundRobinSelector(), usecasePr.Config{MaxReviewers: 2}, logger)
+
+	files := []string{"internal/billing/invoice.go"}
+
+	repo.EXPECT().GetByID(ctx, expertisePRID).Return(nil, dto.ErrNotFound)
+	userRepo.EXPECT().GetByID(ctx, "author").Return(&entity.User{UserID: "author", TeamName: "Backend", IsActive: true}, nil)
+	teamRepo.EXPECT().GetTeamByName(ctx, "Backend").Return(&entity.Team{
+		TeamName: "Backend",
+		Members: []entity.User{
+			{UserID: "author", IsActive: true},
+			{UserID: "b1", IsActive: true},
+		},
+	}, nil)
+	teamRepo.EXPECT().GetFallbackTeams(ctx, "Backend").Return([]string{"Platform"}, nil)
+	teamRepo.EXPECT().GetTeamByName(ctx, "Platform").Return(&entity.Team{
+		TeamName: "Platform",
+		Members: []entity.User{
+			{UserID: "p1", IsActive: true},
+			{UserID: "p2", IsActive: true},
+		},
+	}, nil)
+	userRepo.EXPECT().GetCodeownersRules(ctx, "acme/api").Return([]entity.CodeownersRule{
+		{Position: 1, Pattern: "/internal/billing/", UserIDs: []string{"p2"}},
+	}, nil)
+	userRepo.EXPECT().GetOwnershipPatterns(ctx, []string{"b1"}).Return(nil, nil)
+	userRepo.EXPECT().GetOwnershipPatterns(ctx, []string{"p1", "p2"}).Return(nil, nil)
+	repo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+	resp, err := svc.CreatePR(ctx, &dtoPR.CreatePRRequest{
+		PullRequestID:   expertisePRID,
+		PullRequestName: "Expertise",
+		AuthorID:        "author",
+		Repository:      "acme/api",
+		ChangedFiles:    files,
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, []string{"b1", "p2"}, resp.AssignedReviewers)
+	require.Equal(t, []string{"p2"}, resp.FallbackReviewers)
+}
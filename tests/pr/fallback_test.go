@@ -0,0 +1,115 @@
+package pr_test
+
+import (
+	"context"
+	"testing"
+
+	"pr_reviewer_assignment_service/internal/dto"
+	dtoPR "pr_reviewer_assignment_service/internal/dto/pr"
+	entity "pr_reviewer_assignment_service/internal/entity"
+	usecasePr "pr_reviewer_assignment_service/internal/usecase/pr"
+	mockLogger "pr_reviewer_assignment_service/mocks/logger"
+	mockPR "pr_reviewer_assignment_service/mocks/pr"
+	mockTeam "pr_reviewer_assignment_service/mocks/team"
+	mockUser "pr_reviewer_assignment_service/mocks/user"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const fallbackPRID = "0b7a51c6-8a0e-4d8e-9a59-2f2d3c1e9b10"
+
+func TestCreatePR_FillsFromFallbackTeams(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	repo := mockPR.NewMockPRRepository(ctrl)
+	teamRepo := mockTeam.NewMockTeamRepository(ctrl)
+	userRepo := mockUser.NewMockUserRepository(ctrl)
+	logger := mockLogger.NewMockLogger()
+
+	svc := usecasePr.NewPRService(repo, teamRepo, userRepo, usecasePr.NewRoundRobinSelector(), usecasePr.Config{MaxReviewers: 3}, logger)
+
+	backend := &entity.Team{
+		TeamName: "Backend",
+		Members: []entity.User{
+			{UserID: "author", IsActive: true},
+			{UserID: "b1", IsActive: true},
+		},
+	}
+	platform := &entity.Team{
+		TeamName: "Platform",
+		Members: []entity.User{
+			{UserID: "p1", IsActive: false},
+			{UserID: "p2", IsActive: true},
+		},
+	}
+	infra := &entity.Team{
+		TeamName: "Infra",
+		Members: []entity.User{
+			{UserID: "b1", IsActive: true},
+			{UserID: "i1", IsActive: true},
+			{UserID: "i2", IsActive: true},
+		},
+	}
+
+	repo.EXPECT().GetByID(ctx, fallbackPRID).Return(nil, dto.ErrNotFound)
+	userRepo.EXPECT().GetByID(ctx, "author").Return(&entity.User{UserID: "author", TeamName: "Backend", IsActive: true}, nil)
+	teamRepo.EXPECT().GetTeamByName(ctx, "Backend").Return(backend, nil)
+	teamRepo.EXPECT().GetFallbackTeams(ctx, "Backend").Return([]string{"Gone", "Platform", "Infra"}, nil)
+	teamRepo.EXPECT().GetTeamByName(ctx, "Gone").Return(nil, dto.ErrNotFound)
+	teamRepo.EXPECT().GetTeamByName(ctx, "Platform").Return(platform, nil)
+	teamRepo.EXPECT().GetTeamByName(ctx, "Infra").Return(infra, nil)
+	repo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, p *entity.PullRequest) error {
+		require.Equal(t, []string{"b1", "p2", "i1"}, p.AssignedReviewers)
+		require.Equal(t, []string{"p2", "i1"}, p.FallbackReviewers)
+		return nil
+	})
+
+	resp, err := svc.CreatePR(ctx, &dtoPR.CreatePRRequest{
+		PullRequestID:   fallbackPRID,
+		PullRequestName: "Shared change",
+		AuthorID:        "author",
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, []string{"b1", "p2", "i1"}, resp.AssignedReviewers)
+	require.Equal(t, []string{"p2", "i1"}, resp.FallbackReviewers)
+}
+
+func TestCreatePR_FallbackStillShort(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	repo := mockPR.NewMockPRRepository(ctrl)
+	teamRepo := mockTeam.NewMockTeamRepository(ctrl)
+	userRepo := mockUser.NewMockUserRepository(ctrl)
+	logger := mockLogger.NewMockLogger()
+
+	svc := usecasePr.NewPRService(repo, teamRepo, userRepo, usecasePr.NewRoundRobinSelector(), usecasePr.Config{MaxReviewers: 2}, logger)
+
+	backend := &entity.Team{
+		TeamName: "Backend",
+		Members:  []entity.User{{UserID: "author", IsActive: true}},
+	}
+
+	repo.EXPECT().GetByID(ctx, fallbackPRID).Return(nil, dto.ErrNotFound)
+	userRepo.EXPECT().GetByID(ctx, "author").Return(&entity.User{UserID: "author", TeamName: "Backend", IsActive: true}, nil)
+	teamRepo.EXPECT().GetTeamByName(ctx, "Backend").Return(backend, nil)
+	teamRepo.EXPECT().GetFallbackTeams(ctx, "Backend").Return([]string{"Platform"}, nil)
+	teamRepo.EXPECT().GetTeamByName(ctx, "Platform").Return(&entity.Team{
+		TeamName: "Platform",
+		Members:  []entity.User{{UserID: "p1", IsActive: true}},
+	}, nil)
+
+	count := 2
+	resp, err := svc.CreatePR(ctx, &dtoPR.CreatePRRequest{
+		PullRequestID:   fallbackPRID,
+		PullRequestName: "Shared change",
+		AuthorID:        "author",
+		ReviewersCount:  &count,
+	})
+
+	require.Nil(t, resp)
+	require.ErrorIs(t, err, dto.ErrNotEnoughReviewers)
+}
@@ -0,0 +1,129 @@
+package pr_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"pr_reviewer_assignment_service/internal/dto"
+	entity "pr_reviewer_assignment_service/internal/entity"
+	usecasePr "pr_reviewer_assignment_service/internal/usecase/pr"
+	mockLogger "pr_reviewer_assignment_service/mocks/logger"
+	mockPR "pr_reviewer_assignment_service/mocks/pr"
+	mockTeam "pr_reviewer_assignment_service/mocks/team"
+	mockUser "pr_reviewer_assignment_service/mocks/user"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const getPRID = "d41c8a7e-5f02-4b93-a6e1-7c2b9f0d3e58"
+
+func newGetService(t *testing.T) (*usecasePr.PRService, *mockPR.MockPRRepository, *mockUser.MockUserRepository) {
+	ctrl := gomock.NewController(t)
+	repo := mockPR.NewMockPRRepository(ctrl)
+	userRepo := mockUser.NewMockUserRepository(ctrl)
+	svc := usecasePr.NewPRService(repo, mockTeam.NewMockTeamRepository(ctrl), userRepo,
+		usecasePr.NewRoundRobinSelector(), usecasePr.Config{MaxReviewers: 2}, mockLogger.NewMockLogger())
+	return svc, repo, userRepo
+}
+
+func TestGetPR(t *testing.T) {
+	ctx := context.Background()
+	svc, repo, userRepo := newGetService(t)
+
+	created := time.Date(2025, 4, 1, 12, 0, 0, 0, time.FixedZone("MSK", 3*60*60))
+	merged := time.Date(2025, 4, 2, 9, 30, 0, 0, time.UTC)
+	assigned := time.Date(2025, 4, 1, 9, 0, 5, 0, time.UTC)
+	verdictAt := time.Date(2025, 4, 1, 15, 0, 0, 0, time.UTC)
+
+	repo.EXPECT().GetByID(ctx, getPRID).Return(&entity.PullRequest{
+		PullRequestID:     getPRID,
+		Name:              "Add invoices",
+		AuthorID:          "author",
+		Status:            entity.StatusMerged,
+		AssignedReviewers: []string{"u2", "u3"},
+		Reviews: []entity.Review{
+			{UserID: "u2", Username: "bob", AssignedAt: &assigned, Verdict: entity.VerdictApproved, VerdictAt: &verdictAt},
+			{UserID: "u3", Username: "carol", AssignedAt: &assigned, Fallback: true},
+		},
+		CreatedAt: &created,
+		MergedAt:  &merged,
+	}, nil)
+	userRepo.EXPECT().GetByID(ctx, "author").
+		Return(&entity.User{UserID: "author", Username: "alice", TeamName: "Backend", IsActive: true}, nil)
+
+	resp, err := svc.GetPR(ctx, getPRID)
+	require.NoError(t, err)
+	require.Equal(t, "MERGED", resp.Status)
+	require.Equal(t, "Backend", resp.TeamName)
+	require.Equal(t, "alice", resp.Author.Username)
+	require.True(t, resp.Author.IsActive)
+	require.Equal(t, "2025-04-01T09:00:00Z", *resp.CreatedAt)
+	require.Equal(t, "2025-04-02T09:30:00Z", *resp.MergedAt)
+
+	require.Len(t, resp.Reviewers, 2)
+	require.Equal(t, "bob", resp.Reviewers[0].Username)
+	require.Equal(t, "2025-04-01T09:00:05Z", *resp.Reviewers[0].AssignedAt)
+	require.Equal(t, "APPROVED", resp.Reviewers[0].Verdict)
+	require.Equal(t, "2025-04-01T15:00:00Z", *resp.Reviewers[0].VerdictAt)
+	require.Equal(t, "carol", resp.Reviewers[1].Username)
+	require.Empty(t, resp.Reviewers[1].Verdict)
+	require.Nil(t, resp.Reviewers[1].VerdictAt)
+	require.True(t, resp.Reviewers[1].Fallback)
+}
+
+func TestGetPR_AuthorDeleted(t *testing.T) {
+	ctx := context.Background()
+	svc, repo, userRepo := newGetService(t)
+
+	created := time.Date(2025, 4, 1, 9, 0, 0, 0, time.UTC)
+	repo.EXPECT().GetByID(ctx, getPRID).Return(&entity.PullRequest{
+		PullRequestID: getPRID,
+		Name:          "Orphan",
+		AuthorID:      "gone",
+		TeamName:      "Backend",
+		Status:        entity.StatusOpen,
+		CreatedAt:     &created,
+	}, nil)
+	userRepo.EXPECT().GetByID(ctx, "gone").Return(nil, dto.ErrNotFound)
+
+	resp, err := svc.GetPR(ctx, getPRID)
+	require.NoError(t, err)
+	require.Nil(t, resp.Author)
+	require.Equal(t, "gone", resp.AuthorID)
+	require.Equal(t, "Backend", resp.TeamName)
+	require.NotNil(t, resp.Reviewers)
+	require.Empty(t, resp.Reviewers)
+	require.Nil(t, resp.MergedAt)
+}
+
+func TestGetPR_Errors(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("malformed id", func(t *testing.T) {
+		svc, _, _ := newGetService(t)
+
+		_, err := svc.GetPR(ctx, "not-a-uuid")
+		require.ErrorIs(t, err, dto.ErrNotFound)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		svc, repo, _ := newGetService(t)
+		repo.EXPECT().GetByID(ctx, getPRID).Return(nil, dto.ErrNotFound)
+
+		_, err := svc.GetPR(ctx, getPRID)
+		require.ErrorIs(t, err, dto.ErrNotFound)
+	})
+
+	t.Run("author lookup fails", func(t *testing.T) {
+		svc, repo, userRepo := newGetService(t)
+		dbErr := errors.New("connection reset")
+		repo.EXPECT().GetByID(ctx, getPRID).Return(&entity.PullRequest{PullRequestID: getPRID, AuthorID: "author"}, nil)
+		userRepo.EXPECT().GetByID(ctx, "author").Return(nil, dbErr)
+
+		_, err := svc.GetPR(ctx, getPRID)
+		require.ErrorIs(t, err, dbErr)
+	})
+}
@@ -0,0 +1,263 @@
+package pr_test
+
+import (
+	"context"
+	"testing"
+
+	"pr_reviewer_assignment_service/internal/dto"
+	dtoPR "pr_reviewer_assignment_service/internal/dto/pr"
+	entity "pr_reviewer_assignment_service/internal/entity"
+	usecasePr "pr_reviewer_assignment_service/internal/usecase/pr"
+	mockLogger "pr_reviewer_assignment_service/mocks/logger"
+	mockPR "pr_reviewer_assignment_service/mocks/pr"
+	mockTeam "pr_reviewer_assignment_service/mocks/team"
+	mockUser "pr_reviewer_assignment_service/mocks/user"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const lifecyclePRID = "f0375e25-ffba-4c6f-885d-6c3b8350d81f"
+
+func backendTeam() *entity.Team {
+	return &entity.Team{
+		TeamName: "Backend",
+		Members: []entity.User{
+			{UserID: "author", TeamName: "Backend", IsActive: true},
+			{UserID: "u2", TeamName: "Backend", IsActive: true},
+			{UserID: "u3", TeamName: "Backend", IsActive: true},
+		},
+	}
+}
+
+func TestCreatePR_Draft(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	repo := mockPR.NewMockPRRepository(ctrl)
+	teamRepo := mockTeam.NewMockTeamRepository(ctrl)
+	userRepo := mockUser.NewMockUserRepository(ctrl)
+	logger := mockLogger.NewMockLogger()
+
+	svc := usecasePr.NewPRService(repo, teamRepo, userRepo, usecasePr.NewRoundRobinSelector(), usecasePr.Config{MaxReviewers: 2}, logger)
+
+	req := &dtoPR.CreatePRRequest{
+		PullRequestID:   lifecyclePRID,
+		PullRequestName: "WIP",
+		AuthorID:        "author",
+		Draft:           true,
+	}
+
+	repo.EXPECT().GetByID(ctx, lifecyclePRID).Return(nil, dto.ErrNotFound)
+	userRepo.EXPECT().GetByID(ctx, "author").Return(&entity.User{UserID: "author", TeamName: "Backend", IsActive: true}, nil)
+	repo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, p *entity.PullRequest) error {
+		require.Equal(t, entity.StatusDraft, p.Status)
+		require.Empty(t, p.AssignedReviewers)
+		return nil
+	})
+
+	resp, err := svc.CreatePR(ctx, req)
+
+	require.NoError(t, err)
+	require.Equal(t, string(entity.StatusDraft), resp.Status)
+	require.Empty(t, resp.AssignedReviewers)
+}
+
+func TestMarkReady_AssignsReviewers(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	repo := mockPR.NewMockPRRepository(ctrl)
+	teamRepo := mockTeam.NewMockTeamRepository(ctrl)
+	userRepo := mockUser.NewMockUserRepository(ctrl)
+	logger := mockLogger.NewMockLogger()
+
+	svc := usecasePr.NewPRService(repo, teamRepo, userRepo, usecasePr.NewRoundRobinSelector(), usecasePr.Config{MaxReviewers: 2}, logger)
+
+	repo.EXPECT().GetByID(ctx, lifecyclePRID).Return(&entity.PullRequest{
+		PullRequestID: lifecyclePRID,
+		AuthorID:      "author",
+		Status:        entity.StatusDraft,
+	}, nil)
+	userRepo.EXPECT().GetByID(ctx, "author").Return(&entity.User{UserID: "author", TeamName: "Backend", IsActive: true}, nil)
+	teamRepo.EXPECT().GetTeamByName(ctx, "Backend").Return(backendTeam(), nil)
+	repo.EXPECT().UpdateStatus(ctx, gomock.Any(), entity.StatusDraft).DoAndReturn(
+		func(_ context.Context, p *entity.PullRequest, _ entity.PRStatus) error {
+			require.Equal(t, entity.StatusOpen, p.Status)
+			require.Equal(t, []string{"u2", "u3"}, p.AssignedReviewers)
+			return nil
+		})
+
+	resp, err := svc.MarkReady(ctx, &dtoPR.TransitionRequest{PullRequestID: lifecyclePRID})
+
+	require.NoError(t, err)
+	require.Equal(t, string(entity.StatusOpen), resp.Status)
+	require.Equal(t, []string{"u2", "u3"}, resp.AssignedReviewers)
+}
+
+func TestClosePR_ReleasesReviewers(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	repo := mockPR.NewMockPRRepository(ctrl)
+	logger := mockLogger.NewMockLogger()
+
+	svc := usecasePr.NewPRService(repo, nil, nil, nil, usecasePr.Config{MaxReviewers: 2}, logger)
+
+	repo.EXPECT().GetByID(ctx, lifecyclePRID).Return(&entity.PullRequest{
+		PullRequestID:     lifecyclePRID,
+		AuthorID:          "author",
+		Status:            entity.StatusOpen,
+		AssignedReviewers: []string{"u2", "u3"},
+	}, nil)
+	repo.EXPECT().UpdateStatus(ctx, gomock.Any(), entity.StatusOpen).DoAndReturn(
+		func(_ context.Context, p *entity.PullRequest, _ entity.PRStatus) error {
+			require.Equal(t, entity.StatusClosed, p.Status)
+			require.Empty(t, p.AssignedReviewers)
+			return nil
+		})
+
+	resp, err := svc.ClosePR(ctx, &dtoPR.TransitionRequest{PullRequestID: lifecyclePRID})
+
+	require.NoError(t, err)
+	require.Equal(t, string(entity.StatusClosed), resp.Status)
+	require.Empty(t, resp.AssignedReviewers)
+}
+
+func TestReopenPR_AssignsFreshReviewers(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	repo := mockPR.NewMockPRRepository(ctrl)
+	teamRepo := mockTeam.NewMockTeamRepository(ctrl)
+	userRepo := mockUser.NewMockUserRepository(ctrl)
+	logger := mockLogger.NewMockLogger()
+
+	svc := usecasePr.NewPRService(repo, teamRepo, userRepo, usecasePr.NewRoundRobinSelector(), usecasePr.Config{MaxReviewers: 1}, logger)
+
+	repo.EXPECT().GetByID(ctx, lifecyclePRID).Return(&entity.PullRequest{
+		PullRequestID: lifecyclePRID,
+		AuthorID:      "author",
+		Status:        entity.StatusClosed,
+	}, nil)
+	userRepo.EXPECT().GetByID(ctx, "author").Return(&entity.User{UserID: "author", TeamName: "Backend", IsActive: true}, nil)
+	teamRepo.EXPECT().GetTeamByName(ctx, "Backend").Return(backendTeam(), nil)
+	repo.EXPECT().UpdateStatus(ctx, gomock.Any(), entity.StatusClosed).Return(nil)
+
+	resp, err := svc.ReopenPR(ctx, &dtoPR.TransitionRequest{PullRequestID: lifecyclePRID})
+
+	require.NoError(t, err)
+	require.Equal(t, string(entity.StatusOpen), resp.Status)
+	require.Len(t, resp.AssignedReviewers, 1)
+}
+
+func TestTransitions_Illegal(t *testing.T) {
+	tests := []struct {
+		name   string
+		status entity.PRStatus
+		call   func(svc *usecasePr.PRService, ctx context.Context) error
+	}{
+		{
+			name:   "ready on open",
+			status: entity.StatusOpen,
+			call: func(svc *usecasePr.PRService, ctx context.Context) error {
+				_, err := svc.MarkReady(ctx, &dtoPR.TransitionRequest{PullRequestID: lifecyclePRID})
+				return err
+			},
+		},
+		{
+			name:   "reopen on open",
+			status: entity.StatusOpen,
+			call: func(svc *usecasePr.PRService, ctx context.Context) error {
+				_, err := svc.ReopenPR(ctx, &dtoPR.TransitionRequest{PullRequestID: lifecyclePRID})
+				return err
+			},
+		},
+		{
+			name:   "reopen on draft",
+			status: entity.StatusDraft,
+			call: func(svc *usecasePr.PRService, ctx context.Context) error {
+				_, err := svc.ReopenPR(ctx, &dtoPR.TransitionRequest{PullRequestID: lifecyclePRID})
+				return err
+			},
+		},
+		{
+			name:   "close merged",
+			status: entity.StatusMerged,
+			call: func(svc *usecasePr.PRService, ctx context.Context) error {
+				_, err := svc.ClosePR(ctx, &dtoPR.TransitionRequest{PullRequestID: lifecyclePRID})
+				return err
+			},
+		},
+		{
+			name:   "close closed",
+			status: entity.StatusClosed,
+			call: func(svc *usecasePr.PRService, ctx context.Context) error {
+				_, err := svc.ClosePR(ctx, &dtoPR.TransitionRequest{PullRequestID: lifecyclePRID})
+				return err
+			},
+		},
+		{
+			name:   "merge draft",
+			status: entity.StatusDraft,
+			call: func(svc *usecasePr.PRService, ctx context.Context) error {
+				_, err := svc.MergePR(ctx, &dtoPR.MergeRequest{PullRequestID: lifecyclePRID})
+				return err
+			},
+		},
+		{
+			name:   "merge closed",
+			status: entity.StatusClosed,
+			call: func(svc *usecasePr.PRService, ctx context.Context) error {
+				_, err := svc.MergePR(ctx, &dtoPR.MergeRequest{PullRequestID: lifecyclePRID})
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			ctrl := gomock.NewController(t)
+
+			repo := mockPR.NewMockPRRepository(ctrl)
+			logger := mockLogger.NewMockLogger()
+
+			svc := usecasePr.NewPRService(repo, nil, nil, nil, usecasePr.Config{MaxReviewers: 2}, logger)
+
+			repo.EXPECT().GetByID(ctx, lifecyclePRID).Return(&entity.PullRequest{
+				PullRequestID: lifecyclePRID,
+				AuthorID:      "author",
+				Status:        tt.status,
+			}, nil)
+
+			err := tt.call(svc, ctx)
+
+			require.ErrorIs(t, err, dto.ErrInvalidTransition)
+		})
+	}
+}
+
+func TestReassignReviewer_NotOpen(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	repo := mockPR.NewMockPRRepository(ctrl)
+	logger := mockLogger.NewMockLogger()
+
+	svc := usecasePr.NewPRService(repo, nil, nil, nil, usecasePr.Config{MaxReviewers: 2}, logger)
+
+	repo.EXPECT().GetByID(ctx, lifecyclePRID).Return(&entity.PullRequest{
+		PullRequestID: lifecyclePRID,
+		Status:        entity.StatusClosed,
+	}, nil)
+
+	resp, replacedBy, err := svc.ReassignReviewer(ctx, &dtoPR.ReassignRequest{
+		PullRequestID: lifecyclePRID,
+		OldUserID:     "u2",
+	})
+
+	require.Nil(t, resp)
+	require.Empty(t, replacedBy)
+	require.ErrorIs(t, err, dto.ErrPRNotOpen)
+}
@@ -0,0 +1,169 @@
+package pr_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"pr_reviewer_assignment_service/internal/dto"
+	dtoPR "pr_reviewer_assignment_service/internal/dto/pr"
+	entity "pr_reviewer_assignment_service/internal/entity"
+	usecasePr "pr_reviewer_assignment_service/internal/usecase/pr"
+	mockLogger "pr_reviewer_assignment_service/mocks/logger"
+	mockPR "pr_reviewer_assignment_service/mocks/pr"
+	mockTeam "pr_reviewer_assignment_service/mocks/team"
+	mockUser "pr_reviewer_assignment_service/mocks/user"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	listAuthorID = "3c1d7a52-9e4b-4f0a-8b26-5d7e1f9c0a43"
+	listPR1      = "0a6f3e91-2b7c-4d58-9e14-6c8b2f7d3a05"
+	listPR2      = "5e9d2c14-7a3b-4f86-b0e1-9d4c6a2f8b17"
+	listPR3      = "b7c41f08-3d6e-4a92-8f5b-2e1a9c7d6f30"
+)
+
+func newListService(t *testing.T) (*usecasePr.PRService, *mockPR.MockPRRepository) {
+	ctrl := gomock.NewController(t)
+	repo := mockPR.NewMockPRRepository(ctrl)
+	svc := usecasePr.NewPRService(repo, mockTeam.NewMockTeamRepository(ctrl), mockUser.NewMockUserRepository(ctrl),
+		usecasePr.NewRoundRobinSelector(), usecasePr.Config{MaxReviewers: 2}, mockLogger.NewMockLogger())
+	return svc, repo
+}
+
+func listedPR(id string, createdAt time.Time) *entity.PullRequest {
+	return &entity.PullRequest{
+		PullRequestID:     id,
+		Name:              "PR " + id[:4],
+		AuthorID:          listAuthorID,
+		TeamName:          "Backend",
+		Status:            entity.StatusOpen,
+		AssignedReviewers: []string{"u2"},
+		Reviews:           []entity.Review{{UserID: "u2", Verdict: entity.VerdictApproved}},
+		CreatedAt:         &createdAt,
+	}
+}
+
+func TestListPRs_Defaults(t *testing.T) {
+	ctx := context.Background()
+	svc, repo := newListService(t)
+
+	created := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	repo.EXPECT().List(ctx, entity.PRListFilter{SortBy: entity.SortByCreatedAt, Desc: true, Limit: 51}).
+		Return([]*entity.PullRequest{listedPR(listPR1, created)}, nil)
+
+	resp, err := svc.ListPRs(ctx, &dtoPR.ListPRsRequest{})
+	require.NoError(t, err)
+	require.Nil(t, resp.NextCursor)
+	require.Len(t, resp.PullRequests, 1)
+	require.Equal(t, listPR1, resp.PullRequests[0].PullRequestID)
+	require.Equal(t, []string{"u2"}, resp.PullRequests[0].AssignedReviewers)
+	require.Equal(t, "APPROVED", resp.PullRequests[0].Reviews[0].Verdict)
+	require.Equal(t, "2025-03-01T10:00:00Z", *resp.PullRequests[0].CreatedAt)
+}
+
+func TestListPRs_Filters(t *testing.T) {
+	ctx := context.Background()
+	svc, repo := newListService(t)
+
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+	repo.EXPECT().List(ctx, entity.PRListFilter{
+		Statuses:   []entity.PRStatus{entity.StatusOpen, entity.StatusMerged},
+		AuthorID:   listAuthorID,
+		TeamName:   "Backend",
+		MergedFrom: &from,
+		MergedTo:   &to,
+		SortBy:     entity.SortByMergedAt,
+		Limit:      11,
+	}).Return([]*entity.PullRequest{}, nil)
+
+	resp, err := svc.ListPRs(ctx, &dtoPR.ListPRsRequest{
+		Statuses:   []string{"open", "MERGED"},
+		AuthorID:   listAuthorID,
+		TeamName:   "Backend",
+		MergedFrom: "2025-01-01T03:00:00+03:00",
+		MergedTo:   "2025-02-01T00:00:00Z",
+		SortBy:     "merged_at",
+		Order:      "asc",
+		Limit:      "10",
+	})
+	require.NoError(t, err)
+	require.NotNil(t, resp.PullRequests)
+	require.Empty(t, resp.PullRequests)
+	require.Nil(t, resp.NextCursor)
+}
+
+func TestListPRs_CursorRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	svc, repo := newListService(t)
+
+	t1 := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+	t2 := time.Date(2025, 3, 2, 0, 0, 0, 0, time.UTC)
+	t3 := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	repo.EXPECT().List(ctx, entity.PRListFilter{SortBy: entity.SortByCreatedAt, Desc: true, Limit: 3}).
+		Return([]*entity.PullRequest{listedPR(listPR1, t1), listedPR(listPR2, t2), listedPR(listPR3, t3)}, nil)
+
+	first, err := svc.ListPRs(ctx, &dtoPR.ListPRsRequest{Limit: "2"})
+	require.NoError(t, err)
+	require.Len(t, first.PullRequests, 2)
+	require.NotNil(t, first.NextCursor)
+
+	repo.EXPECT().List(ctx, entity.PRListFilter{
+		SortBy: entity.SortByCreatedAt,
+		Desc:   true,
+		Limit:  3,
+		After:  &entity.PRListKey{At: t2, PullRequestID: listPR2},
+	}).Return([]*entity.PullRequest{listedPR(listPR3, t3)}, nil)
+
+	second, err := svc.ListPRs(ctx, &dtoPR.ListPRsRequest{Limit: "2", Cursor: *first.NextCursor})
+	require.NoError(t, err)
+	require.Len(t, second.PullRequests, 1)
+	require.Equal(t, listPR3, second.PullRequests[0].PullRequestID)
+	require.Nil(t, second.NextCursor)
+
+	_, err = svc.ListPRs(ctx, &dtoPR.ListPRsRequest{Order: "asc", Cursor: *first.NextCursor})
+	require.ErrorIs(t, err, dto.ErrInvalidListQuery)
+
+	_, err = svc.ListPRs(ctx, &dtoPR.ListPRsRequest{TeamName: "Backend", Cursor: *first.NextCursor})
+	require.ErrorIs(t, err, dto.ErrInvalidListQuery)
+
+	_, err = svc.ListPRs(ctx, &dtoPR.ListPRsRequest{Statuses: []string{"OPEN"}, Cursor: *first.NextCursor})
+	require.ErrorIs(t, err, dto.ErrInvalidListQuery)
+}
+
+func TestListPRs_InvalidQuery(t *testing.T) {
+	tests := []struct {
+		name string
+		req  dtoPR.ListPRsRequest
+		want error
+	}{
+		{name: "unknown status", req: dtoPR.ListPRsRequest{Statuses: []string{"DONE"}}, want: dto.ErrInvalidListQuery},
+		{name: "author not uuid", req: dtoPR.ListPRsRequest{AuthorID: "author"}, want: dto.ErrInvalidListQuery},
+		{name: "reviewer not uuid", req: dtoPR.ListPRsRequest{ReviewerID: "u2"}, want: dto.ErrInvalidListQuery},
+		{name: "unknown sort", req: dtoPR.ListPRsRequest{SortBy: "name"}, want: dto.ErrInvalidListQuery},
+		{name: "unknown order", req: dtoPR.ListPRsRequest{Order: "up"}, want: dto.ErrInvalidListQuery},
+		{name: "zero limit", req: dtoPR.ListPRsRequest{Limit: "0"}, want: dto.ErrInvalidListQuery},
+		{name: "limit too big", req: dtoPR.ListPRsRequest{Limit: "201"}, want: dto.ErrInvalidListQuery},
+		{name: "garbage cursor", req: dtoPR.ListPRsRequest{Cursor: "not-a-cursor"}, want: dto.ErrInvalidListQuery},
+		{name: "bad date", req: dtoPR.ListPRsRequest{CreatedFrom: "2025-01-01"}, want: dto.ErrInvalidTimeRange},
+		{
+			name: "empty range",
+			req:  dtoPR.ListPRsRequest{CreatedFrom: "2025-02-01T00:00:00Z", CreatedTo: "2025-01-01T00:00:00Z"},
+			want: dto.ErrInvalidTimeRange,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, _ := newListService(t)
+
+			resp, err := svc.ListPRs(context.Background(), &tt.req)
+			require.ErrorIs(t, err, tt.want)
+			require.Nil(t, resp)
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package pr_test
+
+import (
+	"context"
+	"testing"
+
+	"pr_reviewer_assignment_service/internal/dto"
+	dtoPR "pr_reviewer_assignment_service/internal/dto/pr"
+	entity "pr_reviewer_assignment_service/internal/entity"
+	usecasePr "pr_reviewer_assignment_service/internal/usecase/pr"
+	mockLogger "pr_reviewer_assignment_service/mocks/logger"
+	mockPR "pr_reviewer_assignment_service/mocks/pr"
+	mockTeam "pr_reviewer_assignment_service/mocks/team"
+	mockUser "pr_reviewer_assignment_service/mocks/user"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const membershipPRID = "f0375e25-ffba-4c6f-885d-6c3b8350d81f"
+
+func platformTeam() *entity.Team {
+	return &entity.Team{
+		TeamName: "Platform",
+		Members: []entity.User{
+			{UserID: "author", TeamName: "Backend", IsActive: true, Role: entity.RoleMember},
+			{UserID: "p1", TeamName: "Platform", IsActive: true, Role: entity.RoleLead},
+			{UserID: "p2", TeamName: "Platform", IsActive: false, Role: entity.RoleMember},
+		},
+	}
+}
+
+func TestCreatePR_ExplicitTeam(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	repo := mockPR.NewMockPRRepository(ctrl)
+	teamRepo := mockTeam.NewMockTeamRepository(ctrl)
+	userRepo := mockUser.NewMockUserRepository(ctrl)
+	logger := mockLogger.NewMockLogger()
+
+	svc := usecasePr.NewPRService(repo, teamRepo, userRepo, usecasePr.NewRoundRobinSelector(), usecasePr.Config{MaxReviewers: 2}, logger)
+
+	repo.EXPECT().GetByID(ctx, membershipPRID).Return(nil, dto.ErrNotFound)
+	userRepo.EXPECT().GetByID(ctx, "author").Return(&entity.User{UserID: "author", TeamName: "Backend", IsActive: true}, nil)
+	teamRepo.EXPECT().GetTeamByName(ctx, "Platform").Return(platformTeam(), nil)
+	teamRepo.EXPECT().GetFallbackTeams(ctx, "Platform").Return(nil, nil)
+	repo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, p *entity.PullRequest) error {
+		require.Equal(t, "Platform", p.TeamName)
+		require.Equal(t, []string{"p1"}, p.AssignedReviewers)
+		return nil
+	})
+
+	resp, err := svc.CreatePR(ctx, &dtoPR.CreatePRRequest{
+		PullRequestID:   membershipPRID,
+		PullRequestName: "Infra change",
+		AuthorID:        "author",
+		TeamName:        "Platform",
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, "Platform", resp.TeamName)
+	require.Equal(t, []string{"p1"}, resp.AssignedReviewers)
+}
+
+func TestCreatePR_ExplicitTeamNotMember(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	repo := mockPR.NewMockPRRepository(ctrl)
+	teamRepo := mockTeam.NewMockTeamRepository(ctrl)
+	userRepo := mockUser.NewMockUserRepository(ctrl)
+	logger := mockLogger.NewMockLogger()
+
+	svc := usecasePr.NewPRService(repo, teamRepo, userRepo, usecasePr.NewRoundRobinSelector(), usecasePr.Config{MaxReviewers: 2}, logger)
+
+	repo.EXPECT().GetByID(ctx, membershipPRID).Return(nil, dto.ErrNotFound)
+	userRepo.EXPECT().GetByID(ctx, "outsider").Return(&entity.User{UserID: "outsider", TeamName: "Mobile", IsActive: true}, nil)
+	teamRepo.EXPECT().GetTeamByName(ctx, "Platform").Return(platformTeam(), nil)
+
+	resp, err := svc.CreatePR(ctx, &dtoPR.CreatePRRequest{
+		PullRequestID:   membershipPRID,
+		PullRequestName: "Infra change",
+		AuthorID:        "outsider",
+		TeamName:        "Platform",
+		Draft:           true,
+	})
+
+	require.Nil(t, resp)
+	require.ErrorIs(t, err, dto.ErrNotTeamMember)
+}
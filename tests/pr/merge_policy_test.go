@@ -0,0 +1,307 @@
+package pr_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"pr_reviewer_assignment_service/internal/dto"
+	dtoPR "pr_reviewer_assignment_service/internal/dto/pr"
+	entity "pr_reviewer_assignment_service/internal/entity"
+	usecasePr "pr_reviewer_assignment_service/internal/usecase/pr"
+	mockLogger "pr_reviewer_assignment_service/mocks/logger"
+	mockPR "pr_reviewer_assignment_service/mocks/pr"
+	mockTeam "pr_reviewer_assignment_service/mocks/team"
+	mockUser "pr_reviewer_assignment_service/mocks/user"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const policyPRID = "f0375e25-ffba-4c6f-885d-6c3b8350d81f"
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func TestMergePR_DefaultPolicy(t *testing.T) {
+	tests := []struct {
+		name      string
+		required  int
+		reviews   []entity.Review
+		wantRules []string
+	}{
+		{name: "disabled", required: 0},
+		{
+			name:     "not enough approvals",
+			required: 2,
+			reviews: []entity.Review{
+				{UserID: "u2", Verdict: entity.VerdictApproved},
+				{UserID: "u3", Verdict: entity.VerdictCommented},
+			},
+			wantRules: []string{usecasePr.RuleMinApprovals},
+		},
+		{
+			name:     "enough approvals",
+			required: 2,
+			reviews: []entity.Review{
+				{UserID: "u2", Verdict: entity.VerdictApproved},
+				{UserID: "u3", Verdict: entity.VerdictApproved},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			ctrl := gomock.NewController(t)
+
+			repo := mockPR.NewMockPRRepository(ctrl)
+			teamRepo := mockTeam.NewMockTeamRepository(ctrl)
+			userRepo := mockUser.NewMockUserRepository(ctrl)
+			logger := mockLogger.NewMockLogger()
+
+			svc := usecasePr.NewPRService(repo, teamRepo, userRepo, nil, usecasePr.Config{MaxReviewers: 2, RequiredApprovals: tt.required}, logger)
+
+			repo.EXPECT().GetByID(ctx, policyPRID).Return(&entity.PullRequest{
+				PullRequestID:     policyPRID,
+				AuthorID:          "author",
+				Status:            entity.StatusOpen,
+				AssignedReviewers: []string{"u2", "u3"},
+				Reviews:           tt.reviews,
+			}, nil)
+			userRepo.EXPECT().GetByID(ctx, "author").Return(&entity.User{UserID: "author", TeamName: "Backend", IsActive: true}, nil)
+			teamRepo.EXPECT().GetMergePolicy(ctx, "Backend").Return(nil, dto.ErrNotFound)
+			repo.EXPECT().Merge(ctx, policyPRID, gomock.Any(), gomock.Any()).DoAndReturn(mergeWithReviews(tt.reviews))
+
+			resp, err := svc.MergePR(ctx, &dtoPR.MergeRequest{PullRequestID: policyPRID})
+
+			if len(tt.wantRules) > 0 {
+				require.Nil(t, resp)
+				require.ErrorIs(t, err, dto.ErrMergeBlocked)
+				require.Equal(t, tt.wantRules, violatedRules(t, err))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, string(entity.StatusMerged), resp.Status)
+		})
+	}
+}
+
+func TestMergePR_TeamPolicy(t *testing.T) {
+	tests := []struct {
+		name      string
+		policy    *entity.MergePolicy
+		reviews   []entity.Review
+		wantRules []string
+	}{
+		{
+			name:   "changes requested",
+			policy: &entity.MergePolicy{TeamName: "Backend", MinApprovals: 1, BlockOnChangesRequested: true},
+			reviews: []entity.Review{
+				{UserID: "u2", Verdict: entity.VerdictApproved},
+				{UserID: "u3", Verdict: entity.VerdictChangesRequested},
+			},
+			wantRules: []string{usecasePr.RuleNoChangesRequested},
+		},
+		{
+			name:   "changes requested allowed",
+			policy: &entity.MergePolicy{TeamName: "Backend", MinApprovals: 1},
+			reviews: []entity.Review{
+				{UserID: "u2", Verdict: entity.VerdictApproved},
+				{UserID: "u3", Verdict: entity.VerdictChangesRequested},
+			},
+		},
+		{
+			name:   "no approval besides author",
+			policy: &entity.MergePolicy{TeamName: "Backend", ForbidAuthorOnlyApproval: true},
+			reviews: []entity.Review{
+				{UserID: "u2", Verdict: entity.VerdictCommented},
+				{UserID: "u3"},
+			},
+			wantRules: []string{usecasePr.RuleNonAuthorApproval},
+		},
+		{
+			name:   "approved by reviewer",
+			policy: &entity.MergePolicy{TeamName: "Backend", ForbidAuthorOnlyApproval: true},
+			reviews: []entity.Review{
+				{UserID: "u2", Verdict: entity.VerdictApproved},
+				{UserID: "u3"},
+			},
+		},
+		{
+			name:   "required team missing",
+			policy: &entity.MergePolicy{TeamName: "Backend", MinApprovals: 1, RequiredReviewerTeam: strPtr("Security")},
+			reviews: []entity.Review{
+				{UserID: "u2", Verdict: entity.VerdictApproved},
+			},
+			wantRules: []string{usecasePr.RuleRequiredReviewerTeam},
+		},
+		{
+			name:   "required team approved",
+			policy: &entity.MergePolicy{TeamName: "Backend", MinApprovals: 1, RequiredReviewerTeam: strPtr("Security")},
+			reviews: []entity.Review{
+				{UserID: "sec1", Verdict: entity.VerdictApproved},
+			},
+		},
+		{
+			name: "several rules",
+			policy: &entity.MergePolicy{
+				TeamName:                 "Backend",
+				MinApprovals:             2,
+				BlockOnChangesRequested:  true,
+				ForbidAuthorOnlyApproval: true,
+				RequiredReviewerTeam:     strPtr("Security"),
+			},
+			reviews: []entity.Review{
+				{UserID: "u2", Verdict: entity.VerdictChangesRequested},
+				{UserID: "u3", Verdict: entity.VerdictCommented},
+			},
+			wantRules: []string{
+				usecasePr.RuleMinApprovals,
+				usecasePr.RuleNoChangesRequested,
+				usecasePr.RuleNonAuthorApproval,
+				usecasePr.RuleRequiredReviewerTeam,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			ctrl := gomock.NewController(t)
+
+			repo := mockPR.NewMockPRRepository(ctrl)
+			teamRepo := mockTeam.NewMockTeamRepository(ctrl)
+			userRepo := mockUser.NewMockUserRepository(ctrl)
+			logger := mockLogger.NewMockLogger()
+
+			svc := usecasePr.NewPRService(repo, teamRepo, userRepo, nil, usecasePr.Config{MaxReviewers: 2}, logger)
+
+			repo.EXPECT().GetByID(ctx, policyPRID).Return(&entity.PullRequest{
+				PullRequestID:     policyPRID,
+				AuthorID:          "author",
+				Status:            entity.StatusOpen,
+				AssignedReviewers: []string{"u2", "u3"},
+				Reviews:           tt.reviews,
+			}, nil)
+			userRepo.EXPECT().GetByID(ctx, "author").Return(&entity.User{UserID: "author", TeamName: "Backend", IsActive: true}, nil)
+			teamRepo.EXPECT().GetMergePolicy(ctx, "Backend").Return(tt.policy, nil)
+			if tt.policy.RequiredReviewerTeam != nil {
+				teamRepo.EXPECT().GetTeamByName(ctx, "Security").Return(&entity.Team{
+					TeamName: "Security",
+					Members:  []entity.User{{UserID: "sec1", TeamName: "Security", IsActive: true}},
+				}, nil)
+			}
+			repo.EXPECT().Merge(ctx, policyPRID, gomock.Any(), gomock.Any()).DoAndReturn(mergeWithReviews(tt.reviews))
+
+			resp, err := svc.MergePR(ctx, &dtoPR.MergeRequest{PullRequestID: policyPRID})
+
+			if len(tt.wantRules) > 0 {
+				require.Nil(t, resp)
+				require.ErrorIs(t, err, dto.ErrMergeBlocked)
+				require.Equal(t, tt.wantRules, violatedRules(t, err))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, string(entity.StatusMerged), resp.Status)
+		})
+	}
+}
+
+// mergeWithReviews имитирует Merge репозитория: политика проверяется по
+// вердиктам, прочитанным под блокировкой PR.
+func mergeWithReviews(reviews []entity.Review) func(context.Context, string, *entity.PullRequest, func([]entity.Review) error) error {
+	return func(_ context.Context, _ string, _ *entity.PullRequest, check func([]entity.Review) error) error {
+		return check(reviews)
+	}
+}
+
+func TestMergePR_VerdictSubmittedBeforeLock(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	repo := mockPR.NewMockPRRepository(ctrl)
+	teamRepo := mockTeam.NewMockTeamRepository(ctrl)
+	logger := mockLogger.NewMockLogger()
+
+	svc := usecasePr.NewPRService(repo, teamRepo, nil, nil, usecasePr.Config{MaxReviewers: 2}, logger)
+
+	repo.EXPECT().GetByID(ctx, policyPRID).Return(&entity.PullRequest{
+		PullRequestID:     policyPRID,
+		AuthorID:          "author",
+		TeamName:          "Backend",
+		Status:            entity.StatusOpen,
+		AssignedReviewers: []string{"u2", "u3"},
+		Reviews: []entity.Review{
+			{UserID: "u2", Verdict: entity.VerdictApproved},
+			{UserID: "u3"},
+		},
+	}, nil)
+	teamRepo.EXPECT().GetMergePolicy(ctx, "Backend").
+		Return(&entity.MergePolicy{TeamName: "Backend", MinApprovals: 1, BlockOnChangesRequested: true}, nil)
+	repo.EXPECT().Merge(ctx, policyPRID, gomock.Any(), gomock.Any()).DoAndReturn(mergeWithReviews([]entity.Review{
+		{UserID: "u2", Verdict: entity.VerdictApproved},
+		{UserID: "u3", Verdict: entity.VerdictChangesRequested},
+	}))
+
+	resp, err := svc.MergePR(ctx, &dtoPR.MergeRequest{PullRequestID: policyPRID})
+
+	require.Nil(t, resp)
+	require.Equal(t, []string{usecasePr.RuleNoChangesRequested}, violatedRules(t, err))
+}
+
+func violatedRules(t *testing.T, err error) []string {
+	t.Helper()
+
+	var blocked *dto.MergeBlockedError
+	require.True(t, errors.As(err, &blocked))
+
+	rules := make([]string, 0, len(blocked.Violations))
+	for _, v := range blocked.Violations {
+		rules = append(rules, v.Rule)
+	}
+	return rules
+}
+
+func TestMergePR_PolicyOfPRTeam(t *testing.T) {
+	tests := []struct {
+		name     string
+		authorID string
+	}{
+		{name: "author from another team", authorID: "author"},
+		{name: "author deleted", authorID: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			ctrl := gomock.NewController(t)
+
+			repo := mockPR.NewMockPRRepository(ctrl)
+			teamRepo := mockTeam.NewMockTeamRepository(ctrl)
+			userRepo := mockUser.NewMockUserRepository(ctrl)
+			logger := mockLogger.NewMockLogger()
+
+			svc := usecasePr.NewPRService(repo, teamRepo, userRepo, nil, usecasePr.Config{MaxReviewers: 2}, logger)
+
+			repo.EXPECT().GetByID(ctx, policyPRID).Return(&entity.PullRequest{
+				PullRequestID:     policyPRID,
+				AuthorID:          tt.authorID,
+				TeamName:          "Platform",
+				Status:            entity.StatusOpen,
+				AssignedReviewers: []string{"p1"},
+				Reviews:           []entity.Review{{UserID: "p1", Verdict: entity.VerdictApproved}},
+			}, nil)
+			teamRepo.EXPECT().GetMergePolicy(ctx, "Platform").
+				Return(&entity.MergePolicy{TeamName: "Platform", MinApprovals: 2}, nil)
+			repo.EXPECT().Merge(ctx, policyPRID, gomock.Any(), gomock.Any()).
+				DoAndReturn(mergeWithReviews([]entity.Review{{UserID: "p1", Verdict: entity.VerdictApproved}}))
+
+			resp, err := svc.MergePR(ctx, &dtoPR.MergeRequest{PullRequestID: policyPRID})
+
+			require.Nil(t, resp)
+			require.Equal(t, []string{usecasePr.RuleMinApprovals}, violatedRules(t, err))
+		})
+	}
+}
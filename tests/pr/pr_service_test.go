@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"pr_reviewer_assignment_service/internal/dto"
 	dtoPR "pr_reviewer_assignment_service/internal/dto/pr"
@@ -27,7 +28,7 @@ func TestCreatePR_AlreadyExists(t *testing.T) {
 	userRepo := mockUser.NewMockUserRepository(ctrl)
 	logger := mockLogger.NewMockLogger()
 
-	svc := usecasePr.NewPRService(repo, teamRepo, userRepo, logger)
+	svc := usecasePr.NewPRService(repo, teamRepo, userRepo, usecasePr.NewRoundRobinSelector(), usecasePr.Config{MaxReviewers: 2}, logger)
 
 	req := &dtoPR.CreatePRRequest{
 		PullRequestID:   "f0375e25-ffba-4c6f-885d-6c3b8350d81f",
@@ -53,7 +54,7 @@ func TestCreatePR_AuthorNotFound(t *testing.T) {
 	userRepo := mockUser.NewMockUserRepository(ctrl)
 	logger := mockLogger.NewMockLogger()
 
-	svc := usecasePr.NewPRService(repo, teamRepo, userRepo, logger)
+	svc := usecasePr.NewPRService(repo, teamRepo, userRepo, usecasePr.NewRoundRobinSelector(), usecasePr.Config{MaxReviewers: 2}, logger)
 
 	req := &dtoPR.CreatePRRequest{
 		PullRequestID: "f0375e25-ffba-4c6f-885d-6c3b8350d81f",
@@ -79,7 +80,7 @@ func TestCreatePR_TeamNotFound(t *testing.T) {
 	userRepo := mockUser.NewMockUserRepository(ctrl)
 	logger := mockLogger.NewMockLogger()
 
-	svc := usecasePr.NewPRService(repo, teamRepo, userRepo, logger)
+	svc := usecasePr.NewPRService(repo, teamRepo, userRepo, usecasePr.NewRoundRobinSelector(), usecasePr.Config{MaxReviewers: 2}, logger)
 
 	req := &dtoPR.CreatePRRequest{
 		PullRequestID: "f0375e25-ffba-4c6f-885d-6c3b8350d81f",
@@ -114,7 +115,7 @@ func TestCreatePR_RepoCreateError(t *testing.T) {
 	userRepo := mockUser.NewMockUserRepository(ctrl)
 	logger := mockLogger.NewMockLogger()
 
-	svc := usecasePr.NewPRService(repo, teamRepo, userRepo, logger)
+	svc := usecasePr.NewPRService(repo, teamRepo, userRepo, usecasePr.NewRoundRobinSelector(), usecasePr.Config{MaxReviewers: 2}, logger)
 
 	req := &dtoPR.CreatePRRequest{
 		PullRequestID: "f0375e25-ffba-4c6f-885d-6c3b8350d81f",
@@ -131,6 +132,7 @@ func TestCreatePR_RepoCreateError(t *testing.T) {
 	repo.EXPECT().GetByID(ctx, req.PullRequestID).Return(nil, nil)
 	userRepo.EXPECT().GetByID(ctx, req.AuthorID).Return(user, nil)
 	teamRepo.EXPECT().GetTeamByName(ctx, "Backend").Return(team, nil)
+	teamRepo.EXPECT().GetFallbackTeams(ctx, "Backend").Return(nil, nil)
 
 	repo.EXPECT().Create(ctx, gomock.Any()).Return(errors.New("db fail"))
 
@@ -140,6 +142,123 @@ func TestCreatePR_RepoCreateError(t *testing.T) {
 	require.EqualError(t, err, "db fail")
 }
 
+func TestCreatePR_SelectsActiveNonAuthorReviewers(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	repo := mockPR.NewMockPRRepository(ctrl)
+	teamRepo := mockTeam.NewMockTeamRepository(ctrl)
+	userRepo := mockUser.NewMockUserRepository(ctrl)
+	logger := mockLogger.NewMockLogger()
+
+	svc := usecasePr.NewPRService(repo, teamRepo, userRepo, usecasePr.NewRoundRobinSelector(), usecasePr.Config{MaxReviewers: 2}, logger)
+
+	req := &dtoPR.CreatePRRequest{
+		PullRequestID:   "f0375e25-ffba-4c6f-885d-6c3b8350d81f",
+		PullRequestName: "Test",
+		AuthorID:        "u1",
+	}
+
+	user := &entity.User{UserID: req.AuthorID, TeamName: "Backend", IsActive: true}
+
+	team := &entity.Team{
+		TeamName: "Backend",
+		Members: []entity.User{
+			{UserID: "u1", IsActive: true},
+			{UserID: "u2", IsActive: false},
+			{UserID: "u3", IsActive: true},
+			{UserID: "u6", IsActive: true, Absent: true},
+			{UserID: "u4", IsActive: true},
+			{UserID: "u5", IsActive: true},
+		},
+	}
+
+	repo.EXPECT().GetByID(ctx, req.PullRequestID).Return(nil, nil)
+	userRepo.EXPECT().GetByID(ctx, req.AuthorID).Return(user, nil)
+	teamRepo.EXPECT().GetTeamByName(ctx, "Backend").Return(team, nil)
+	repo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+	resp, err := svc.CreatePR(ctx, req)
+
+	require.NoError(t, err)
+	require.Equal(t, []string{"u3", "u4"}, resp.AssignedReviewers)
+	require.NotNil(t, resp.CreatedAt)
+	require.Nil(t, resp.MergedAt)
+}
+
+func TestCreatePR_ReviewersCount(t *testing.T) {
+	intPtr := func(v int) *int { return &v }
+
+	tests := []struct {
+		name          string
+		cfgMax        int
+		teamMax       *int
+		requested     *int
+		wantReviewers int
+		wantErr       error
+	}{
+		{name: "config default", cfgMax: 2, wantReviewers: 2},
+		{name: "team override", cfgMax: 2, teamMax: intPtr(3), wantReviewers: 3},
+		{name: "team override zero", cfgMax: 2, teamMax: intPtr(0), wantReviewers: 0},
+		{name: "team override above candidates", cfgMax: 2, teamMax: intPtr(10), wantReviewers: 4},
+		{name: "request override", cfgMax: 2, teamMax: intPtr(3), requested: intPtr(1), wantReviewers: 1},
+		{name: "request override all", cfgMax: 2, requested: intPtr(4), wantReviewers: 4},
+		{name: "request more than candidates", cfgMax: 2, requested: intPtr(5), wantErr: dto.ErrNotEnoughReviewers},
+		{name: "request negative", cfgMax: 2, requested: intPtr(-1), wantErr: dto.ErrInvalidReviewersCount},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			ctrl := gomock.NewController(t)
+
+			repo := mockPR.NewMockPRRepository(ctrl)
+			teamRepo := mockTeam.NewMockTeamRepository(ctrl)
+			userRepo := mockUser.NewMockUserRepository(ctrl)
+			logger := mockLogger.NewMockLogger()
+
+			svc := usecasePr.NewPRService(repo, teamRepo, userRepo, usecasePr.NewRoundRobinSelector(), usecasePr.Config{MaxReviewers: tt.cfgMax}, logger)
+
+			req := &dtoPR.CreatePRRequest{
+				PullRequestID:   "f0375e25-ffba-4c6f-885d-6c3b8350d81f",
+				PullRequestName: "Test",
+				AuthorID:        "u1",
+				ReviewersCount:  tt.requested,
+			}
+
+			team := &entity.Team{
+				TeamName:     "Backend",
+				MaxReviewers: tt.teamMax,
+				Members: []entity.User{
+					{UserID: "u1", IsActive: true},
+					{UserID: "u2", IsActive: true},
+					{UserID: "u3", IsActive: true},
+					{UserID: "u4", IsActive: true},
+					{UserID: "u5", IsActive: true},
+				},
+			}
+
+			repo.EXPECT().GetByID(ctx, req.PullRequestID).Return(nil, nil)
+			userRepo.EXPECT().GetByID(ctx, req.AuthorID).Return(&entity.User{UserID: "u1", TeamName: "Backend"}, nil)
+			teamRepo.EXPECT().GetTeamByName(ctx, "Backend").Return(team, nil)
+			teamRepo.EXPECT().GetFallbackTeams(ctx, "Backend").Return(nil, nil).MaxTimes(1)
+			if tt.wantErr == nil {
+				repo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+			}
+
+			resp, err := svc.CreatePR(ctx, req)
+
+			if tt.wantErr != nil {
+				require.Nil(t, resp)
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, resp.AssignedReviewers, tt.wantReviewers)
+		})
+	}
+}
+
 func TestMergePR_NotFound(t *testing.T) {
 	ctx := context.Background()
 	ctrl := gomock.NewController(t)
@@ -147,7 +266,7 @@ func TestMergePR_NotFound(t *testing.T) {
 	repo := mockPR.NewMockPRRepository(ctrl)
 	logger := mockLogger.NewMockLogger()
 
-	svc := usecasePr.NewPRService(repo, nil, nil, logger)
+	svc := usecasePr.NewPRService(repo, nil, nil, nil, usecasePr.Config{MaxReviewers: 2}, logger)
 
 	req := &dtoPR.MergeRequest{PullRequestID: "f0375e25-ffba-4c6f-885d-6c3b8350d81f"}
 
@@ -168,11 +287,14 @@ func TestMergePR_AlreadyMerged(t *testing.T) {
 	repo := mockPR.NewMockPRRepository(ctrl)
 	logger := mockLogger.NewMockLogger()
 
-	svc := usecasePr.NewPRService(repo, nil, nil, logger)
+	svc := usecasePr.NewPRService(repo, nil, nil, nil, usecasePr.Config{MaxReviewers: 2}, logger)
 
+	mergedAt := time.Date(2025, 11, 20, 10, 30, 0, 0, time.UTC)
 	prEntity := &entity.PullRequest{
-		PullRequestID: "f0375e25-ffba-4c6f-885d-6c3b8350d81f",
-		Status:        entity.StatusMerged,
+		PullRequestID:     "f0375e25-ffba-4c6f-885d-6c3b8350d81f",
+		Status:            entity.StatusMerged,
+		AssignedReviewers: []string{"u2"},
+		MergedAt:          &mergedAt,
 	}
 
 	req := &dtoPR.MergeRequest{PullRequestID: prEntity.PullRequestID}
@@ -181,8 +303,36 @@ func TestMergePR_AlreadyMerged(t *testing.T) {
 
 	resp, err := svc.MergePR(ctx, req)
 
-	require.Nil(t, resp)
-	require.ErrorIs(t, err, dto.ErrPRMerged)
+	require.NoError(t, err)
+	require.Equal(t, string(entity.StatusMerged), resp.Status)
+	require.NotNil(t, resp.MergedAt)
+	require.Equal(t, "2025-11-20T10:30:00Z", *resp.MergedAt)
+}
+
+func TestMergePR_MergedConcurrently(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	repo := mockPR.NewMockPRRepository(ctrl)
+	userRepo := mockUser.NewMockUserRepository(ctrl)
+	logger := mockLogger.NewMockLogger()
+
+	svc := usecasePr.NewPRService(repo, nil, userRepo, nil, usecasePr.Config{MaxReviewers: 2}, logger)
+
+	prID := "f0375e25-ffba-4c6f-885d-6c3b8350d81f"
+	mergedAt := time.Date(2025, 11, 20, 10, 30, 0, 0, time.UTC)
+
+	gomock.InOrder(
+		repo.EXPECT().GetByID(ctx, prID).Return(&entity.PullRequest{PullRequestID: prID, Status: entity.StatusOpen}, nil),
+		repo.EXPECT().Merge(ctx, prID, gomock.Any(), gomock.Any()).Return(dto.ErrPRMerged),
+		repo.EXPECT().GetByID(ctx, prID).Return(&entity.PullRequest{PullRequestID: prID, Status: entity.StatusMerged, MergedAt: &mergedAt}, nil),
+	)
+
+	resp, err := svc.MergePR(ctx, &dtoPR.MergeRequest{PullRequestID: prID})
+
+	require.NoError(t, err)
+	require.Equal(t, string(entity.StatusMerged), resp.Status)
+	require.Equal(t, "2025-11-20T10:30:00Z", *resp.MergedAt)
 }
 
 func TestMergePR_RepoError(t *testing.T) {
@@ -190,9 +340,10 @@ func TestMergePR_RepoError(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
 	repo := mockPR.NewMockPRRepository(ctrl)
+	userRepo := mockUser.NewMockUserRepository(ctrl)
 	logger := mockLogger.NewMockLogger()
 
-	svc := usecasePr.NewPRService(repo, nil, nil, logger)
+	svc := usecasePr.NewPRService(repo, nil, userRepo, nil, usecasePr.Config{MaxReviewers: 2}, logger)
 
 	prEntity := &entity.PullRequest{
 		PullRequestID: "f0375e25-ffba-4c6f-885d-6c3b8350d81f",
@@ -202,7 +353,7 @@ func TestMergePR_RepoError(t *testing.T) {
 	req := &dtoPR.MergeRequest{PullRequestID: "f0375e25-ffba-4c6f-885d-6c3b8350d81f"}
 
 	repo.EXPECT().GetByID(ctx, "f0375e25-ffba-4c6f-885d-6c3b8350d81f").Return(prEntity, nil)
-	repo.EXPECT().Merge(ctx, "f0375e25-ffba-4c6f-885d-6c3b8350d81f", gomock.Any()).
+	repo.EXPECT().Merge(ctx, "f0375e25-ffba-4c6f-885d-6c3b8350d81f", gomock.Any(), gomock.Any()).
 		Return(errors.New("merge failed"))
 
 	resp, err := svc.MergePR(ctx, req)
@@ -216,9 +367,10 @@ func TestMergePR_Success(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
 	repo := mockPR.NewMockPRRepository(ctrl)
+	userRepo := mockUser.NewMockUserRepository(ctrl)
 	logger := mockLogger.NewMockLogger()
 
-	svc := usecasePr.NewPRService(repo, nil, nil, logger)
+	svc := usecasePr.NewPRService(repo, nil, userRepo, nil, usecasePr.Config{MaxReviewers: 2}, logger)
 
 	prEntity := &entity.PullRequest{
 		PullRequestID: "f0375e25-ffba-4c6f-885d-6c3b8350d81f",
@@ -228,13 +380,131 @@ func TestMergePR_Success(t *testing.T) {
 	req := &dtoPR.MergeRequest{PullRequestID: "f0375e25-ffba-4c6f-885d-6c3b8350d81f"}
 
 	repo.EXPECT().GetByID(ctx, "f0375e25-ffba-4c6f-885d-6c3b8350d81f").Return(prEntity, nil)
-	repo.EXPECT().Merge(ctx, "f0375e25-ffba-4c6f-885d-6c3b8350d81f", gomock.Any()).Return(nil)
+	repo.EXPECT().Merge(ctx, "f0375e25-ffba-4c6f-885d-6c3b8350d81f", gomock.Any(), gomock.Any()).Return(nil)
 
 	resp, err := svc.MergePR(ctx, req)
 
 	require.NoError(t, err)
 	require.Equal(t, "f0375e25-ffba-4c6f-885d-6c3b8350d81f", resp.PullRequestID)
 	require.Equal(t, string(entity.StatusMerged), resp.Status)
+	require.NotNil(t, resp.MergedAt)
+}
+
+func TestReassignReviewer_PRNotFound(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	repo := mockPR.NewMockPRRepository(ctrl)
+	logger := mockLogger.NewMockLogger()
+
+	svc := usecasePr.NewPRService(repo, nil, nil, nil, usecasePr.Config{MaxReviewers: 2}, logger)
+
+	req := &dtoPR.ReassignRequest{
+		PullRequestID: "f0375e25-ffba-4c6f-885d-6c3b8350d81f",
+		OldUserID:     "old",
+	}
+
+	repo.EXPECT().GetByID(ctx, req.PullRequestID).Return(nil, dto.ErrNotFound)
+
+	resp, replacedBy, err := svc.ReassignReviewer(ctx, req)
+
+	require.Nil(t, resp)
+	require.Empty(t, replacedBy)
+	require.ErrorIs(t, err, dto.ErrNotFound)
+}
+
+func TestReassignReviewer_Merged(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	repo := mockPR.NewMockPRRepository(ctrl)
+	logger := mockLogger.NewMockLogger()
+
+	svc := usecasePr.NewPRService(repo, nil, nil, nil, usecasePr.Config{MaxReviewers: 2}, logger)
+
+	req := &dtoPR.ReassignRequest{
+		PullRequestID: "f0375e25-ffba-4c6f-885d-6c3b8350d81f",
+		OldUserID:     "old",
+	}
+
+	repo.EXPECT().GetByID(ctx, req.PullRequestID).Return(&entity.PullRequest{
+		PullRequestID:     req.PullRequestID,
+		Status:            entity.StatusMerged,
+		AssignedReviewers: []string{"old"},
+	}, nil)
+
+	resp, replacedBy, err := svc.ReassignReviewer(ctx, req)
+
+	require.Nil(t, resp)
+	require.Empty(t, replacedBy)
+	require.ErrorIs(t, err, dto.ErrPRMerged)
+}
+
+func TestReassignReviewer_NotAssigned(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	repo := mockPR.NewMockPRRepository(ctrl)
+	logger := mockLogger.NewMockLogger()
+
+	svc := usecasePr.NewPRService(repo, nil, nil, nil, usecasePr.Config{MaxReviewers: 2}, logger)
+
+	req := &dtoPR.ReassignRequest{
+		PullRequestID: "f0375e25-ffba-4c6f-885d-6c3b8350d81f",
+		OldUserID:     "old",
+	}
+
+	repo.EXPECT().GetByID(ctx, req.PullRequestID).Return(&entity.PullRequest{
+		PullRequestID:     req.PullRequestID,
+		Status:            entity.StatusOpen,
+		AssignedReviewers: []string{"other"},
+	}, nil)
+
+	resp, replacedBy, err := svc.ReassignReviewer(ctx, req)
+
+	require.Nil(t, resp)
+	require.Empty(t, replacedBy)
+	require.ErrorIs(t, err, dto.ErrNotAssigned)
+}
+
+func TestReassignReviewer_NoCandidate(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	repo := mockPR.NewMockPRRepository(ctrl)
+	teamRepo := mockTeam.NewMockTeamRepository(ctrl)
+	userRepo := mockUser.NewMockUserRepository(ctrl)
+	logger := mockLogger.NewMockLogger()
+
+	svc := usecasePr.NewPRService(repo, teamRepo, userRepo, nil, usecasePr.Config{MaxReviewers: 2}, logger)
+
+	req := &dtoPR.ReassignRequest{
+		PullRequestID: "f0375e25-ffba-4c6f-885d-6c3b8350d81f",
+		OldUserID:     "old",
+	}
+
+	repo.EXPECT().GetByID(ctx, req.PullRequestID).Return(&entity.PullRequest{
+		PullRequestID:     req.PullRequestID,
+		AuthorID:          "author",
+		Status:            entity.StatusOpen,
+		AssignedReviewers: []string{"old", "second"},
+	}, nil)
+	userRepo.EXPECT().GetByID(ctx, "author").Return(&entity.User{UserID: "author", TeamName: "Backend"}, nil)
+	teamRepo.EXPECT().GetTeamByName(ctx, "Backend").Return(&entity.Team{
+		TeamName: "Backend",
+		Members: []entity.User{
+			{UserID: "author", IsActive: true},
+			{UserID: "old", IsActive: true},
+			{UserID: "second", IsActive: true},
+			{UserID: "inactive", IsActive: false},
+		},
+	}, nil)
+
+	resp, replacedBy, err := svc.ReassignReviewer(ctx, req)
+
+	require.Nil(t, resp)
+	require.Empty(t, replacedBy)
+	require.ErrorIs(t, err, dto.ErrNoCandidate)
 }
 
 func TestReassignReviewer_RepoError(t *testing.T) {
@@ -242,17 +512,34 @@ func TestReassignReviewer_RepoError(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
 	repo := mockPR.NewMockPRRepository(ctrl)
+	teamRepo := mockTeam.NewMockTeamRepository(ctrl)
+	userRepo := mockUser.NewMockUserRepository(ctrl)
 	logger := mockLogger.NewMockLogger()
 
-	svc := usecasePr.NewPRService(repo, nil, nil, logger)
+	svc := usecasePr.NewPRService(repo, teamRepo, userRepo, nil, usecasePr.Config{MaxReviewers: 2}, logger)
 
 	req := &dtoPR.ReassignRequest{
 		PullRequestID: "f0375e25-ffba-4c6f-885d-6c3b8350d81f",
 		OldUserID:     "old",
 	}
 
+	repo.EXPECT().GetByID(ctx, req.PullRequestID).Return(&entity.PullRequest{
+		PullRequestID:     req.PullRequestID,
+		AuthorID:          "author",
+		Status:            entity.StatusOpen,
+		AssignedReviewers: []string{"old"},
+	}, nil)
+	userRepo.EXPECT().GetByID(ctx, "author").Return(&entity.User{UserID: "author", TeamName: "Backend"}, nil)
+	teamRepo.EXPECT().GetTeamByName(ctx, "Backend").Return(&entity.Team{
+		TeamName: "Backend",
+		Members: []entity.User{
+			{UserID: "old", IsActive: true},
+			{UserID: "new", IsActive: true},
+		},
+	}, nil)
+	repo.EXPECT().CountOpenReviews(ctx, []string{"new"}).Return(map[string]int{}, nil)
 	repo.EXPECT().
-		ReassignReviewer(ctx, req.PullRequestID, "old", "").
+		ReassignReviewer(ctx, req.PullRequestID, "old", "new").
 		Return(nil, errors.New("fail"))
 
 	resp, replacedBy, err := svc.ReassignReviewer(ctx, req)
@@ -267,19 +554,19 @@ func TestReassignReviewer_Success(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
 	repo := mockPR.NewMockPRRepository(ctrl)
+	teamRepo := mockTeam.NewMockTeamRepository(ctrl)
+	userRepo := mockUser.NewMockUserRepository(ctrl)
 	logger := mockLogger.NewMockLogger()
 
-	svc := usecasePr.NewPRService(repo, nil, nil, logger)
+	svc := usecasePr.NewPRService(repo, teamRepo, userRepo, nil, usecasePr.Config{MaxReviewers: 2}, logger)
 
-	prEntity := &entity.PullRequest{
-		PullRequestID: "f0375e25-ffba-4c6f-885d-6c3b8350d81f",
-		Name:          "PR",
-		AuthorID:      "40ef164f-5bd3-4196-b1e3-c9ed9a652579",
-		Status:        entity.StatusOpen,
-		AssignedReviewers: []string{
-			"new",
-			"old",
-		},
+	current := &entity.PullRequest{
+		PullRequestID:     "f0375e25-ffba-4c6f-885d-6c3b8350d81f",
+		Name:              "PR",
+		AuthorID:          "author",
+		TeamName:          "Backend",
+		Status:            entity.StatusOpen,
+		AssignedReviewers: []string{"old", "second"},
 	}
 
 	req := &dtoPR.ReassignRequest{
@@ -287,13 +574,219 @@ func TestReassignReviewer_Success(t *testing.T) {
 		OldUserID:     "old",
 	}
 
+	repo.EXPECT().GetByID(ctx, req.PullRequestID).Return(current, nil)
+	teamRepo.EXPECT().GetTeamByName(ctx, "Backend").Return(&entity.Team{
+		TeamName: "Backend",
+		Members: []entity.User{
+			{UserID: "author", IsActive: true},
+			{UserID: "old", IsActive: true},
+			{UserID: "second", IsActive: true},
+			{UserID: "busy", IsActive: true},
+			{UserID: "idle", IsActive: true},
+			{UserID: "inactive", IsActive: false},
+		},
+	}, nil)
+	repo.EXPECT().CountOpenReviews(ctx, []string{"busy", "idle"}).
+		Return(map[string]int{"busy": 4, "idle": 1}, nil)
 	repo.EXPECT().
-		ReassignReviewer(ctx, "f0375e25-ffba-4c6f-885d-6c3b8350d81f", "old", "").
-		Return(prEntity, nil)
+		ReassignReviewer(ctx, req.PullRequestID, "old", "idle").
+		Return(&entity.PullRequest{
+			PullRequestID:     current.PullRequestID,
+			Name:              current.Name,
+			AuthorID:          current.AuthorID,
+			Status:            entity.StatusOpen,
+			AssignedReviewers: []string{"second", "idle"},
+		}, nil)
 
 	resp, replacedBy, err := svc.ReassignReviewer(ctx, req)
 
 	require.NoError(t, err)
 	require.NotNil(t, resp)
-	require.Equal(t, "new", replacedBy)
+	require.Equal(t, "idle", replacedBy)
+	require.ElementsMatch(t, []string{"second", "idle"}, resp.AssignedReviewers)
+}
+
+func TestReassignReviewer_ExplicitTarget(t *testing.T) {
+	tests := []struct {
+		name      string
+		target    string
+		prTeam    string
+		users     map[string]*entity.User
+		members   []entity.User
+		teamLimit *int
+		wantErr   error
+	}{
+		{
+			name:    "target is author",
+			target:  "author",
+			wantErr: dto.ErrReviewerIsAuthor,
+		},
+		{
+			name:    "target already assigned",
+			target:  "second",
+			wantErr: dto.ErrAlreadyAssigned,
+		},
+		{
+			name:    "target not found",
+			target:  "ghost",
+			users:   map[string]*entity.User{},
+			wantErr: dto.ErrNotFound,
+		},
+		{
+			name:   "target inactive",
+			target: "sleepy",
+			users: map[string]*entity.User{
+				"sleepy": {UserID: "sleepy", TeamName: "Backend", IsActive: false},
+			},
+			wantErr: dto.ErrReviewerInactive,
+		},
+		{
+			name:   "target absent",
+			target: "away",
+			users: map[string]*entity.User{
+				"away": {UserID: "away", TeamName: "Backend", IsActive: true, Absent: true},
+			},
+			wantErr: dto.ErrReviewerAbsent,
+		},
+		{
+			name:   "target from foreign team",
+			target: "stranger",
+			users: map[string]*entity.User{
+				"stranger": {UserID: "stranger", TeamName: "Mobile", IsActive: true},
+				"author":   {UserID: "author", TeamName: "Frontend", IsActive: true},
+			},
+			members: []entity.User{{UserID: "author", IsActive: true}},
+			wantErr: dto.ErrTeamNotAllowed,
+		},
+		{
+			name:   "target from old reviewer team outside PR team",
+			target: "mate",
+			prTeam: "Frontend",
+			users: map[string]*entity.User{
+				"mate": {UserID: "mate", TeamName: "Backend", IsActive: true},
+			},
+			members: []entity.User{{UserID: "front", IsActive: true}},
+			wantErr: dto.ErrTeamNotAllowed,
+		},
+		{
+			name:   "target with inactive membership in PR team",
+			target: "front",
+			prTeam: "Frontend",
+			users: map[string]*entity.User{
+				"front": {UserID: "front", TeamName: "Frontend", IsActive: true},
+			},
+			members: []entity.User{{UserID: "front", IsActive: false}},
+			wantErr: dto.ErrReviewerInactive,
+		},
+		{
+			name:   "target at own limit",
+			target: "busy",
+			prTeam: "Backend",
+			users: map[string]*entity.User{
+				"busy": {UserID: "busy", TeamName: "Backend", IsActive: true, MaxOpenReviews: intPtr(2)},
+			},
+			members: []entity.User{{UserID: "busy", IsActive: true}},
+			wantErr: dto.ErrReviewerAtLimit,
+		},
+		{
+			name:   "target at team limit",
+			target: "front",
+			users: map[string]*entity.User{
+				"front":  {UserID: "front", TeamName: "Frontend", IsActive: true},
+				"author": {UserID: "author", TeamName: "Frontend", IsActive: true},
+			},
+			members:   []entity.User{{UserID: "front", IsActive: true}},
+			teamLimit: intPtr(1),
+			wantErr:   dto.ErrReviewerAtLimit,
+		},
+		{
+			name:   "target at PR team limit with another primary team",
+			target: "guest",
+			prTeam: "Backend",
+			users: map[string]*entity.User{
+				"guest": {UserID: "guest", TeamName: "Mobile", IsActive: true},
+			},
+			members:   []entity.User{{UserID: "guest", IsActive: true}},
+			teamLimit: intPtr(2),
+			wantErr:   dto.ErrReviewerAtLimit,
+		},
+		{
+			name:   "target from PR team with another primary team",
+			target: "guest",
+			prTeam: "Backend",
+			users: map[string]*entity.User{
+				"guest": {UserID: "guest", TeamName: "Mobile", IsActive: true},
+			},
+			members: []entity.User{{UserID: "guest", IsActive: true}},
+		},
+		{
+			name:   "target from author team",
+			target: "front",
+			users: map[string]*entity.User{
+				"front":  {UserID: "front", TeamName: "Frontend", IsActive: true},
+				"author": {UserID: "author", TeamName: "Frontend", IsActive: true},
+			},
+			members: []entity.User{{UserID: "front", IsActive: true}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			ctrl := gomock.NewController(t)
+
+			repo := mockPR.NewMockPRRepository(ctrl)
+			teamRepo := mockTeam.NewMockTeamRepository(ctrl)
+			userRepo := mockUser.NewMockUserRepository(ctrl)
+			logger := mockLogger.NewMockLogger()
+
+			svc := usecasePr.NewPRService(repo, teamRepo, userRepo, nil, usecasePr.Config{MaxReviewers: 2}, logger)
+
+			req := &dtoPR.ReassignRequest{
+				PullRequestID: "f0375e25-ffba-4c6f-885d-6c3b8350d81f",
+				OldUserID:     "old",
+				NewUserID:     tt.target,
+			}
+
+			teamRepo.EXPECT().GetTeamByName(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, name string) (*entity.Team, error) {
+				return &entity.Team{TeamName: name, MaxOpenReviews: tt.teamLimit, Members: tt.members}, nil
+			}).MaxTimes(1)
+			repo.EXPECT().CountOpenReviews(ctx, []string{tt.target}).Return(map[string]int{tt.target: 2}, nil).AnyTimes()
+
+			repo.EXPECT().GetByID(ctx, req.PullRequestID).Return(&entity.PullRequest{
+				PullRequestID:     req.PullRequestID,
+				AuthorID:          "author",
+				TeamName:          tt.prTeam,
+				Status:            entity.StatusOpen,
+				AssignedReviewers: []string{"old", "second"},
+			}, nil)
+			userRepo.EXPECT().GetByID(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, userID string) (*entity.User, error) {
+				if u, ok := tt.users[userID]; ok {
+					return u, nil
+				}
+				return nil, dto.ErrNotFound
+			}).AnyTimes()
+
+			if tt.wantErr == nil {
+				repo.EXPECT().ReassignReviewer(ctx, req.PullRequestID, "old", tt.target).
+					Return(&entity.PullRequest{
+						PullRequestID:     req.PullRequestID,
+						AuthorID:          "author",
+						Status:            entity.StatusOpen,
+						AssignedReviewers: []string{"second", tt.target},
+					}, nil)
+			}
+
+			resp, replacedBy, err := svc.ReassignReviewer(ctx, req)
+
+			if tt.wantErr != nil {
+				require.Nil(t, resp)
+				require.Empty(t, replacedBy)
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.target, replacedBy)
+		})
+	}
 }
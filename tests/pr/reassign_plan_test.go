@@ -0,0 +1,155 @@
+package pr_test
+
+import (
+	"context"
+	"testing"
+
+	"pr_reviewer_assignment_service/internal/dto"
+	entity "pr_reviewer_assignment_service/internal/entity"
+	usecasePr "pr_reviewer_assignment_service/internal/usecase/pr"
+	mockLogger "pr_reviewer_assignment_service/mocks/logger"
+	mockPR "pr_reviewer_assignment_service/mocks/pr"
+	mockTeam "pr_reviewer_assignment_service/mocks/team"
+	mockUser "pr_reviewer_assignment_service/mocks/user"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanReassignments(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	repo := mockPR.NewMockPRRepository(ctrl)
+	teamRepo := mockTeam.NewMockTeamRepository(ctrl)
+	userRepo := mockUser.NewMockUserRepository(ctrl)
+	logger := mockLogger.NewMockLogger()
+
+	svc := usecasePr.NewPRService(repo, teamRepo, userRepo, nil, usecasePr.Config{MaxReviewers: 2}, logger)
+
+	repo.EXPECT().GetOpenByReviewers(ctx, []string{"gone"}).Return([]*entity.PullRequest{
+		{PullRequestID: "pr-1", AuthorID: "author", TeamName: "Backend", Status: entity.StatusOpen, AssignedReviewers: []string{"gone", "b1"}},
+		{PullRequestID: "pr-2", AuthorID: "b2", TeamName: "Backend", Status: entity.StatusOpen, AssignedReviewers: []string{"gone"}},
+		{PullRequestID: "pr-3", AuthorID: "m1", TeamName: "Mobile", Status: entity.StatusOpen, AssignedReviewers: []string{"gone"}},
+	}, nil)
+	teamRepo.EXPECT().GetTeamByName(ctx, "Backend").Return(&entity.Team{
+		TeamName: "Backend",
+		Members: []entity.User{
+			{UserID: "author", IsActive: true},
+			{UserID: "gone", IsActive: true},
+			{UserID: "b1", IsActive: true},
+			{UserID: "b2", IsActive: true},
+			{UserID: "b3", IsActive: true, MaxOpenReviews: intPtr(1)},
+			{UserID: "b4", IsActive: false},
+			{UserID: "b5", IsActive: true, Absent: true},
+		},
+	}, nil)
+	teamRepo.EXPECT().GetTeamByName(ctx, "Mobile").Return(nil, dto.ErrNotFound)
+	repo.EXPECT().CountOpenReviews(ctx, []string{"author", "b1", "b2", "b3", "b4", "b5"}).
+		Return(map[string]int{"b1": 2, "b2": 1}, nil)
+
+	plan, err := svc.PlanReassignments(ctx, []string{"gone"})
+
+	require.NoError(t, err)
+	require.Equal(t, []entity.Reassignment{
+		{PullRequestID: "pr-1", OldUserID: "gone", NewUserID: "b3"},
+		{PullRequestID: "pr-2", OldUserID: "gone", NewUserID: "author"},
+		{PullRequestID: "pr-3", OldUserID: "gone"},
+	}, plan)
+}
+
+func TestPlanReassignments_SeveralLeavingReviewers(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	repo := mockPR.NewMockPRRepository(ctrl)
+	teamRepo := mockTeam.NewMockTeamRepository(ctrl)
+	logger := mockLogger.NewMockLogger()
+
+	svc := usecasePr.NewPRService(repo, teamRepo, nil, nil, usecasePr.Config{MaxReviewers: 2}, logger)
+
+	repo.EXPECT().GetOpenByReviewers(ctx, []string{"u1", "u2"}).Return([]*entity.PullRequest{
+		{PullRequestID: "pr-1", AuthorID: "author", TeamName: "Backend", Status: entity.StatusOpen, AssignedReviewers: []string{"u1", "u2"}},
+	}, nil)
+	teamRepo.EXPECT().GetTeamByName(ctx, "Backend").Return(&entity.Team{
+		TeamName: "Backend",
+		Members: []entity.User{
+			{UserID: "author", IsActive: true},
+			{UserID: "u1", IsActive: true},
+			{UserID: "u2", IsActive: true},
+			{UserID: "u3", IsActive: true},
+		},
+	}, nil)
+	repo.EXPECT().CountOpenReviews(ctx, []string{"author", "u3"}).Return(map[string]int{}, nil)
+
+	plan, err := svc.PlanReassignments(ctx, []string{"u1", "u2"})
+
+	require.NoError(t, err)
+	require.Equal(t, []entity.Reassignment{
+		{PullRequestID: "pr-1", OldUserID: "u1", NewUserID: "u3"},
+		{PullRequestID: "pr-1", OldUserID: "u2"},
+	}, plan)
+}
+
+func TestPlanTeamReassignments_OnlyTeamPRs(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	repo := mockPR.NewMockPRRepository(ctrl)
+	teamRepo := mockTeam.NewMockTeamRepository(ctrl)
+	logger := mockLogger.NewMockLogger()
+
+	svc := usecasePr.NewPRService(repo, teamRepo, nil, nil, usecasePr.Config{MaxReviewers: 2}, logger)
+
+	repo.EXPECT().GetOpenByReviewers(ctx, []string{"gone"}).Return([]*entity.PullRequest{
+		{PullRequestID: "pr-1", AuthorID: "author", TeamName: "Backend", Status: entity.StatusOpen, AssignedReviewers: []string{"gone"}},
+		{PullRequestID: "pr-2", AuthorID: "m1", TeamName: "Mobile", Status: entity.StatusOpen, AssignedReviewers: []string{"gone"}},
+	}, nil)
+	teamRepo.EXPECT().GetTeamByName(ctx, "Backend").Return(&entity.Team{
+		TeamName: "Backend",
+		Members: []entity.User{
+			{UserID: "author", IsActive: true},
+			{UserID: "gone", IsActive: true},
+			{UserID: "b1", IsActive: true},
+		},
+	}, nil)
+	repo.EXPECT().CountOpenReviews(ctx, []string{"author", "b1"}).Return(map[string]int{}, nil)
+
+	plan, err := svc.PlanTeamReassignments(ctx, []string{"gone"}, "Backend")
+
+	require.NoError(t, err)
+	require.Equal(t, []entity.Reassignment{
+		{PullRequestID: "pr-1", OldUserID: "gone", NewUserID: "b1"},
+	}, plan)
+}
+
+func TestPlanArchiveReassignments_NoReplacementFromArchivedTeam(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	repo := mockPR.NewMockPRRepository(ctrl)
+	teamRepo := mockTeam.NewMockTeamRepository(ctrl)
+	logger := mockLogger.NewMockLogger()
+
+	svc := usecasePr.NewPRService(repo, teamRepo, nil, nil, usecasePr.Config{MaxReviewers: 2}, logger)
+
+	repo.EXPECT().GetOpenByReviewers(ctx, []string{"gone", "stay"}).Return([]*entity.PullRequest{
+		{PullRequestID: "pr-1", AuthorID: "a1", TeamName: "Legacy", Status: entity.StatusOpen, AssignedReviewers: []string{"gone", "stay"}},
+		{PullRequestID: "pr-2", AuthorID: "m1", TeamName: "Mobile", Status: entity.StatusOpen, AssignedReviewers: []string{"gone"}},
+		{PullRequestID: "pr-3", AuthorID: "m1", TeamName: "Mobile", Status: entity.StatusOpen, AssignedReviewers: []string{"stay"}},
+	}, nil)
+	teamRepo.EXPECT().GetTeamByName(ctx, "Mobile").Return(&entity.Team{
+		TeamName: "Mobile",
+		Members:  []entity.User{{UserID: "m1", IsActive: true}, {UserID: "m2", IsActive: true}},
+	}, nil)
+	repo.EXPECT().CountOpenReviews(ctx, []string{"m1", "m2"}).Return(map[string]int{}, nil)
+
+	plan, err := svc.PlanArchiveReassignments(ctx, []string{"gone", "stay"}, "Legacy", []string{"stay"})
+
+	require.NoError(t, err)
+	require.Equal(t, []entity.Reassignment{
+		{PullRequestID: "pr-1", OldUserID: "gone"},
+		{PullRequestID: "pr-1", OldUserID: "stay"},
+		{PullRequestID: "pr-2", OldUserID: "gone", NewUserID: "m2"},
+	}, plan)
+}
@@ -0,0 +1,123 @@
+package pr_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"pr_reviewer_assignment_service/internal/dto"
+	dtoPR "pr_reviewer_assignment_service/internal/dto/pr"
+	entity "pr_reviewer_assignment_service/internal/entity"
+	usecasePr "pr_reviewer_assignment_service/internal/usecase/pr"
+	mockLogger "pr_reviewer_assignment_service/mocks/logger"
+	mockPR "pr_reviewer_assignment_service/mocks/pr"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const reviewPRID = "f0375e25-ffba-4c6f-885d-6c3b8350d81f"
+
+func TestSubmitReview_InvalidVerdict(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	repo := mockPR.NewMockPRRepository(ctrl)
+	logger := mockLogger.NewMockLogger()
+
+	svc := usecasePr.NewPRService(repo, nil, nil, nil, usecasePr.Config{MaxReviewers: 2}, logger)
+
+	resp, err := svc.SubmitReview(ctx, &dtoPR.ReviewRequest{
+		PullRequestID: reviewPRID,
+		UserID:        "u2",
+		Verdict:       "LGTM",
+	})
+
+	require.Nil(t, resp)
+	require.ErrorIs(t, err, dto.ErrInvalidVerdict)
+}
+
+func TestSubmitReview_Rejected(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  entity.PRStatus
+		userID  string
+		wantErr error
+	}{
+		{name: "merged", status: entity.StatusMerged, userID: "u2", wantErr: dto.ErrPRMerged},
+		{name: "closed", status: entity.StatusClosed, userID: "u2", wantErr: dto.ErrPRNotOpen},
+		{name: "not assigned", status: entity.StatusOpen, userID: "u9", wantErr: dto.ErrNotAssigned},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			ctrl := gomock.NewController(t)
+
+			repo := mockPR.NewMockPRRepository(ctrl)
+			logger := mockLogger.NewMockLogger()
+
+			svc := usecasePr.NewPRService(repo, nil, nil, nil, usecasePr.Config{MaxReviewers: 2}, logger)
+
+			repo.EXPECT().GetByID(ctx, reviewPRID).Return(&entity.PullRequest{
+				PullRequestID:     reviewPRID,
+				Status:            tt.status,
+				AssignedReviewers: []string{"u2", "u3"},
+			}, nil)
+
+			resp, err := svc.SubmitReview(ctx, &dtoPR.ReviewRequest{
+				PullRequestID: reviewPRID,
+				UserID:        tt.userID,
+				Verdict:       string(entity.VerdictApproved),
+			})
+
+			require.Nil(t, resp)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestSubmitReview_Success(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	repo := mockPR.NewMockPRRepository(ctrl)
+	logger := mockLogger.NewMockLogger()
+
+	svc := usecasePr.NewPRService(repo, nil, nil, nil, usecasePr.Config{MaxReviewers: 2}, logger)
+
+	assignedAt := time.Date(2025, 11, 20, 9, 0, 0, 0, time.UTC)
+	verdictAt := time.Date(2025, 11, 20, 11, 15, 0, 0, time.UTC)
+
+	gomock.InOrder(
+		repo.EXPECT().GetByID(ctx, reviewPRID).Return(&entity.PullRequest{
+			PullRequestID:     reviewPRID,
+			Status:            entity.StatusOpen,
+			AssignedReviewers: []string{"u2", "u3"},
+		}, nil),
+		repo.EXPECT().SubmitVerdict(ctx, reviewPRID, "u2", entity.VerdictChangesRequested, gomock.Any()).Return(nil),
+		repo.EXPECT().GetByID(ctx, reviewPRID).Return(&entity.PullRequest{
+			PullRequestID:     reviewPRID,
+			Status:            entity.StatusOpen,
+			AssignedReviewers: []string{"u2", "u3"},
+			Reviews: []entity.Review{
+				{UserID: "u2", Verdict: entity.VerdictChangesRequested, AssignedAt: &assignedAt, VerdictAt: &verdictAt},
+				{UserID: "u3", AssignedAt: &assignedAt},
+			},
+		}, nil),
+	)
+
+	resp, err := svc.SubmitReview(ctx, &dtoPR.ReviewRequest{
+		PullRequestID: reviewPRID,
+		UserID:        "u2",
+		Verdict:       string(entity.VerdictChangesRequested),
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Reviews, 2)
+	require.Equal(t, "CHANGES_REQUESTED", resp.Reviews[0].Verdict)
+	require.Equal(t, "2025-11-20T11:15:00Z", *resp.Reviews[0].VerdictAt)
+	require.Equal(t, "2025-11-20T09:00:00Z", *resp.Reviews[0].AssignedAt)
+	require.Empty(t, resp.Reviews[1].Verdict)
+	require.Nil(t, resp.Reviews[1].VerdictAt)
+}
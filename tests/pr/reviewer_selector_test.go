@@ -0,0 +1,197 @@
+package pr_test
+
+import (
+	"context"
+	"testing"
+
+	usecasePr "pr_reviewer_assignment_service/internal/usecase/pr"
+	mockPR "pr_reviewer_assignment_service/mocks/pr"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewReviewerSelector_UnknownStrategy(t *testing.T) {
+	selector, err := usecasePr.NewReviewerSelector("first_two", nil)
+
+	require.Nil(t, selector)
+	require.Error(t, err)
+}
+
+func TestRoundRobinSelector_EvenDistribution(t *testing.T) {
+	ctx := context.Background()
+	selector := usecasePr.NewRoundRobinSelector()
+
+	candidates := []string{"u3", "u1", "u4", "u2"}
+	counts := map[string]int{}
+
+	for i := 0; i < 10; i++ {
+		reviewers, err := selector.Select(ctx, "Backend", candidates, 2)
+		require.NoError(t, err)
+		require.Len(t, reviewers, 2)
+		require.NotEqual(t, reviewers[0], reviewers[1])
+		for _, r := range reviewers {
+			counts[r]++
+		}
+	}
+
+	for _, c := range candidates {
+		require.Equal(t, 5, counts[c], c)
+	}
+}
+
+func TestRoundRobinSelector_RotatesPerTeam(t *testing.T) {
+	ctx := context.Background()
+	selector := usecasePr.NewRoundRobinSelector()
+
+	first, err := selector.Select(ctx, "Backend", []string{"a", "b", "c"}, 1)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a"}, first)
+
+	other, err := selector.Select(ctx, "Frontend", []string{"a", "b", "c"}, 1)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a"}, other)
+
+	second, err := selector.Select(ctx, "Backend", []string{"a", "b", "c"}, 1)
+	require.NoError(t, err)
+	require.Equal(t, []string{"b"}, second)
+}
+
+func TestRoundRobinSelector_CandidateSetChanges(t *testing.T) {
+	ctx := context.Background()
+	selector := usecasePr.NewRoundRobinSelector()
+
+	_, err := selector.Select(ctx, "Backend", []string{"a", "b", "c", "d"}, 2)
+	require.NoError(t, err)
+
+	// "b" was selected last; the author "c" is excluded now, so rotation continues with "d".
+	reviewers, err := selector.Select(ctx, "Backend", []string{"a", "b", "d"}, 2)
+	require.NoError(t, err)
+	require.Equal(t, []string{"d", "a"}, reviewers)
+}
+
+func TestRoundRobinSelector_RankedAdvancesOnce(t *testing.T) {
+	ctx := context.Background()
+	selector := usecasePr.NewRoundRobinSelector()
+
+	reviewers, err := selector.SelectRanked(ctx, "Backend", [][]string{{"c"}, {"a", "b", "d"}}, 2)
+	require.NoError(t, err)
+	require.Equal(t, []string{"c", "d"}, reviewers)
+
+	// The cursor stopped at "d", so a plain selection continues with "a".
+	next, err := selector.Select(ctx, "Backend", []string{"a", "b", "c", "d"}, 1)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a"}, next)
+}
+
+func TestRandomSelector_UniformDistribution(t *testing.T) {
+	ctx := context.Background()
+	selector := usecasePr.NewRandomSelector()
+
+	candidates := []string{"u1", "u2", "u3", "u4"}
+	const rounds = 20000
+	counts := map[string]int{}
+
+	for i := 0; i < rounds; i++ {
+		reviewers, err := selector.Select(ctx, "Backend", candidates, 2)
+		require.NoError(t, err)
+		require.Len(t, reviewers, 2)
+		require.NotEqual(t, reviewers[0], reviewers[1])
+		for _, r := range reviewers {
+			counts[r]++
+		}
+	}
+
+	expected := rounds * 2 / len(candidates)
+	for _, c := range candidates {
+		require.InDelta(t, expected, counts[c], float64(expected)*0.05, c)
+	}
+}
+
+func TestSelectors_CountBounds(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	repo := mockPR.NewMockPRRepository(ctrl)
+	repo.EXPECT().CountOpenReviews(ctx, gomock.Any()).Return(map[string]int{}, nil).AnyTimes()
+
+	selectors := map[string]usecasePr.ReviewerSelector{
+		usecasePr.StrategyRoundRobin:  usecasePr.NewRoundRobinSelector(),
+		usecasePr.StrategyRandom:      usecasePr.NewRandomSelector(),
+		usecasePr.StrategyLeastLoaded: usecasePr.NewLeastLoadedSelector(repo),
+	}
+
+	for name, selector := range selectors {
+		t.Run(name, func(t *testing.T) {
+			reviewers, err := selector.Select(ctx, "Backend", []string{"u1"}, 2)
+			require.NoError(t, err)
+			require.Equal(t, []string{"u1"}, reviewers)
+
+			reviewers, err = selector.Select(ctx, "Backend", []string{}, 2)
+			require.NoError(t, err)
+			require.Empty(t, reviewers)
+		})
+	}
+}
+
+func TestLeastLoadedSelector_PrefersLowestLoad(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	repo := mockPR.NewMockPRRepository(ctrl)
+	selector := usecasePr.NewLeastLoadedSelector(repo)
+
+	candidates := []string{"busy", "idle", "medium", "overloaded"}
+
+	repo.EXPECT().CountOpenReviews(ctx, candidates).
+		Return(map[string]int{"busy": 5, "medium": 2, "overloaded": 9}, nil).
+		Times(100)
+
+	for i := 0; i < 100; i++ {
+		reviewers, err := selector.Select(ctx, "Backend", candidates, 2)
+		require.NoError(t, err)
+		require.Equal(t, []string{"idle", "medium"}, reviewers)
+	}
+}
+
+func TestLeastLoadedSelector_SpreadsTies(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	repo := mockPR.NewMockPRRepository(ctrl)
+	selector := usecasePr.NewLeastLoadedSelector(repo)
+
+	candidates := []string{"u1", "u2", "u3"}
+	const rounds = 6000
+
+	repo.EXPECT().CountOpenReviews(ctx, candidates).
+		Return(map[string]int{"u1": 1, "u2": 1, "u3": 4}, nil).
+		Times(rounds)
+
+	counts := map[string]int{}
+	for i := 0; i < rounds; i++ {
+		reviewers, err := selector.Select(ctx, "Backend", candidates, 1)
+		require.NoError(t, err)
+		require.Len(t, reviewers, 1)
+		counts[reviewers[0]]++
+	}
+
+	require.Zero(t, counts["u3"])
+	require.InDelta(t, rounds/2, counts["u1"], rounds*0.05)
+	require.InDelta(t, rounds/2, counts["u2"], rounds*0.05)
+}
+
+func TestLeastLoadedSelector_RankedPrefersFirstGroup(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	repo := mockPR.NewMockPRRepository(ctrl)
+	selector := usecasePr.NewLeastLoadedSelector(repo)
+
+	repo.EXPECT().CountOpenReviews(ctx, []string{"owner", "idle", "medium"}).
+		Return(map[string]int{"owner": 7, "medium": 2}, nil)
+
+	reviewers, err := selector.SelectRanked(ctx, "Backend", [][]string{{"owner"}, {"idle", "medium"}}, 2)
+	require.NoError(t, err)
+	require.Equal(t, []string{"owner", "idle"}, reviewers)
+}
@@ -0,0 +1,144 @@
+package stats_test
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"pr_reviewer_assignment_service/internal/dto"
+	entity "pr_reviewer_assignment_service/internal/entity"
+	usecase "pr_reviewer_assignment_service/internal/usecase/stats"
+	mockLogger "pr_reviewer_assignment_service/mocks/logger"
+	mockStats "pr_reviewer_assignment_service/mocks/stats"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func fairnessLoads() []entity.MemberLoad {
+	return []entity.MemberLoad{
+		{TeamName: "backend", UserID: "u1", OpenReviews: 0},
+		{TeamName: "backend", UserID: "u2", OpenReviews: 0},
+		{TeamName: "backend", UserID: "u3", OpenReviews: 6},
+		{TeamName: "frontend", UserID: "u4", OpenReviews: 2},
+		{TeamName: "frontend", UserID: "u5", OpenReviews: 2},
+		{TeamName: "qa", UserID: "u6", OpenReviews: 1},
+		{TeamName: "qa", UserID: "u7", OpenReviews: 3},
+	}
+}
+
+func TestStatsService_GetFairness(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	repo := mockStats.NewMockStatsRepository(ctrl)
+	service := usecase.NewStatsService(repo, usecase.Config{ImbalanceThreshold: 0.5}, mockLogger.NewMockLogger())
+
+	repo.EXPECT().GetMemberLoads(ctx, "").Return(fairnessLoads(), nil)
+
+	resp, err := service.GetFairness(ctx, "")
+	require.NoError(t, err)
+	require.Equal(t, 0.5, resp.Threshold)
+	require.Len(t, resp.Teams, 3)
+
+	backend := resp.Teams[0]
+	require.Equal(t, "backend", backend.TeamName)
+	require.Equal(t, 3, backend.Members)
+	require.Equal(t, 6, backend.OpenReviews)
+	require.InDelta(t, 2.0/3.0, backend.Gini, 1e-9)
+	require.Nil(t, backend.MaxMinRatio)
+	require.InDelta(t, math.Sqrt(8), backend.StdDev, 1e-9)
+	require.True(t, backend.Imbalanced)
+
+	frontend := resp.Teams[1]
+	require.Zero(t, frontend.Gini)
+	require.Equal(t, 1.0, *frontend.MaxMinRatio)
+	require.Zero(t, frontend.StdDev)
+	require.False(t, frontend.Imbalanced)
+
+	qa := resp.Teams[2]
+	require.InDelta(t, 0.25, qa.Gini, 1e-9)
+	require.Equal(t, 3.0, *qa.MaxMinRatio)
+	require.InDelta(t, 1.0, qa.StdDev, 1e-9)
+	require.False(t, qa.Imbalanced)
+}
+
+func TestStatsService_GetFairness_Team(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	repo := mockStats.NewMockStatsRepository(ctrl)
+	service := usecase.NewStatsService(repo, usecase.Config{ImbalanceThreshold: 0.5}, mockLogger.NewMockLogger())
+
+	t.Run("found", func(t *testing.T) {
+		repo.EXPECT().TeamExists(ctx, "qa").Return(true, nil)
+		repo.EXPECT().GetMemberLoads(ctx, "qa").Return(fairnessLoads()[5:], nil)
+
+		resp, err := service.GetFairness(ctx, "qa")
+		require.NoError(t, err)
+		require.Len(t, resp.Teams, 1)
+		require.Equal(t, "qa", resp.Teams[0].TeamName)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		repo.EXPECT().TeamExists(ctx, "ghost").Return(false, nil)
+
+		resp, err := service.GetFairness(ctx, "ghost")
+		require.Nil(t, resp)
+		require.ErrorIs(t, err, dto.ErrNotFound)
+	})
+}
+
+func TestStatsService_CheckImbalance(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("emits events on transitions", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		repo := mockStats.NewMockStatsRepository(ctrl)
+		service := usecase.NewStatsService(repo, usecase.Config{ImbalanceThreshold: 0.5}, mockLogger.NewMockLogger())
+
+		want := []entity.ImbalanceEvent{
+			{TeamName: "backend", Imbalanced: true, Gini: 2.0 / 3.0, Threshold: 0.5},
+			{TeamName: "frontend", Imbalanced: false, Gini: 0, Threshold: 0.5},
+		}
+
+		gomock.InOrder(
+			repo.EXPECT().GetMemberLoads(ctx, "").Return(fairnessLoads(), nil),
+			repo.EXPECT().GetImbalanceStates(ctx).Return(map[string]bool{"frontend": true}, nil),
+			repo.EXPECT().RecordImbalanceEvents(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, events []entity.ImbalanceEvent) error {
+				require.Len(t, events, 2)
+				require.Equal(t, want[0].TeamName, events[0].TeamName)
+				require.InDelta(t, want[0].Gini, events[0].Gini, 1e-9)
+				require.True(t, events[0].Imbalanced)
+				require.Equal(t, want[1], events[1])
+				return nil
+			}),
+		)
+
+		events, err := service.CheckImbalance(ctx)
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+	})
+
+	t.Run("no transitions", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		repo := mockStats.NewMockStatsRepository(ctrl)
+		service := usecase.NewStatsService(repo, usecase.Config{ImbalanceThreshold: 0.5}, mockLogger.NewMockLogger())
+
+		repo.EXPECT().GetMemberLoads(ctx, "").Return(fairnessLoads(), nil)
+		repo.EXPECT().GetImbalanceStates(ctx).Return(map[string]bool{"backend": true}, nil)
+
+		events, err := service.CheckImbalance(ctx)
+		require.NoError(t, err)
+		require.Empty(t, events)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		service := usecase.NewStatsService(mockStats.NewMockStatsRepository(ctrl), usecase.Config{}, mockLogger.NewMockLogger())
+
+		events, err := service.CheckImbalance(ctx)
+		require.NoError(t, err)
+		require.Empty(t, events)
+	})
+}
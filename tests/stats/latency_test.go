@@ -0,0 +1,78 @@
+package stats_test
+
+import (
+	"context"
+	"testing"
+
+	"pr_reviewer_assignment_service/internal/dto"
+	dtoStats "pr_reviewer_assignment_service/internal/dto/stats"
+	entity "pr_reviewer_assignment_service/internal/entity"
+	usecase "pr_reviewer_assignment_service/internal/usecase/stats"
+	mockLogger "pr_reviewer_assignment_service/mocks/logger"
+	mockStats "pr_reviewer_assignment_service/mocks/stats"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatsService_GetLatency(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	repo := mockStats.NewMockStatsRepository(ctrl)
+	service := usecase.NewStatsService(repo, usecase.Config{}, mockLogger.NewMockLogger())
+
+	filter := entity.StatsFilter{TeamName: "backend"}
+	repo.EXPECT().TeamExists(ctx, "backend").Return(true, nil)
+	repo.EXPECT().GetTeamLatency(ctx, filter).Return([]entity.LatencyStats{
+		{TeamName: "backend", Metric: entity.LatencyFirstReview, Samples: 4, P50: 3600, P90: 7200, P99: 9000},
+		{TeamName: "backend", Metric: entity.LatencyMerge, Samples: 3, P50: 86400, P90: 172800, P99: 200000},
+	}, nil)
+	repo.EXPECT().GetReviewerLatency(ctx, filter).Return([]entity.LatencyStats{
+		{UserID: "uuid-1", Username: "alice", Metric: entity.LatencyFirstReview, Samples: 2, P50: 1800, P90: 3000, P99: 3500},
+		{UserID: "uuid-1", Username: "alice", Metric: entity.LatencyMerge, Samples: 1, P50: 90000, P90: 90000, P99: 90000},
+		{UserID: "uuid-2", Username: "bob", Metric: entity.LatencyMerge, Samples: 2, P50: 50000, P90: 60000, P99: 61000},
+	}, nil)
+
+	resp, err := service.GetLatency(ctx, &dtoStats.StatsRequest{TeamName: "backend"})
+	require.NoError(t, err)
+
+	require.Equal(t, []dtoStats.TeamLatencyDTO{{
+		TeamName: "backend",
+		LatencyDTO: dtoStats.LatencyDTO{
+			TimeToFirstReview: &dtoStats.PercentilesDTO{Samples: 4, P50Seconds: 3600, P90Seconds: 7200, P99Seconds: 9000},
+			TimeToMerge:       &dtoStats.PercentilesDTO{Samples: 3, P50Seconds: 86400, P90Seconds: 172800, P99Seconds: 200000},
+		},
+	}}, resp.Teams)
+
+	require.Len(t, resp.Reviewers, 2)
+	require.Equal(t, 2, resp.Reviewers[0].TimeToFirstReview.Samples)
+	require.Equal(t, float64(90000), resp.Reviewers[0].TimeToMerge.P50Seconds)
+	require.Equal(t, "bob", resp.Reviewers[1].Username)
+	require.Nil(t, resp.Reviewers[1].TimeToFirstReview)
+}
+
+func TestStatsService_GetLatency_TeamNotFound(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	repo := mockStats.NewMockStatsRepository(ctrl)
+	service := usecase.NewStatsService(repo, usecase.Config{}, mockLogger.NewMockLogger())
+
+	repo.EXPECT().TeamExists(ctx, "ghost").Return(false, nil)
+
+	resp, err := service.GetLatency(ctx, &dtoStats.StatsRequest{TeamName: "ghost"})
+	require.Nil(t, resp)
+	require.ErrorIs(t, err, dto.ErrNotFound)
+}
+
+func TestStatsService_GetLatency_InvalidRange(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	service := usecase.NewStatsService(mockStats.NewMockStatsRepository(ctrl), usecase.Config{}, mockLogger.NewMockLogger())
+
+	resp, err := service.GetLatency(ctx, &dtoStats.StatsRequest{From: "2025-11-01T00:00:00Z", To: "2025-11-01T00:00:00Z"})
+	require.Nil(t, resp)
+	require.ErrorIs(t, err, dto.ErrInvalidTimeRange)
+}
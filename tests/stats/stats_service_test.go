@@ -0,0 +1,100 @@
+package stats_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"pr_reviewer_assignment_service/internal/dto"
+	dtoStats "pr_reviewer_assignment_service/internal/dto/stats"
+	entity "pr_reviewer_assignment_service/internal/entity"
+	usecase "pr_reviewer_assignment_service/internal/usecase/stats"
+	mockLogger "pr_reviewer_assignment_service/mocks/logger"
+	mockStats "pr_reviewer_assignment_service/mocks/stats"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatsService_GetReviewerStats_InvalidRange(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	service := usecase.NewStatsService(mockStats.NewMockStatsRepository(ctrl), usecase.Config{}, mockLogger.NewMockLogger())
+
+	for name, req := range map[string]*dtoStats.StatsRequest{
+		"bad from":    {From: "yesterday"},
+		"bad to":      {To: "2025-13-01T00:00:00Z"},
+		"empty range": {From: "2025-11-02T00:00:00Z", To: "2025-11-01T00:00:00Z"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			resp, err := service.GetReviewerStats(ctx, req)
+			require.Nil(t, resp)
+			require.ErrorIs(t, err, dto.ErrInvalidTimeRange)
+		})
+	}
+}
+
+func TestStatsService_GetReviewerStats_TeamNotFound(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	repo := mockStats.NewMockStatsRepository(ctrl)
+	service := usecase.NewStatsService(repo, usecase.Config{}, mockLogger.NewMockLogger())
+
+	repo.EXPECT().TeamExists(ctx, "ghost").Return(false, nil)
+
+	resp, err := service.GetReviewerStats(ctx, &dtoStats.StatsRequest{TeamName: "ghost"})
+	require.Nil(t, resp)
+	require.ErrorIs(t, err, dto.ErrNotFound)
+}
+
+func TestStatsService_GetReviewerStats_TeamWithoutStats(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	repo := mockStats.NewMockStatsRepository(ctrl)
+	service := usecase.NewStatsService(repo, usecase.Config{}, mockLogger.NewMockLogger())
+
+	filter := entity.StatsFilter{TeamName: "archived"}
+
+	repo.EXPECT().TeamExists(ctx, "archived").Return(true, nil)
+	repo.EXPECT().GetTeamReviewStats(ctx, filter).Return([]entity.TeamReviewStats{}, nil)
+	repo.EXPECT().GetReviewerStats(ctx, filter).Return([]entity.ReviewerStats{}, nil)
+
+	resp, err := service.GetReviewerStats(ctx, &dtoStats.StatsRequest{TeamName: "archived"})
+	require.NoError(t, err)
+	require.Empty(t, resp.Teams)
+	require.Empty(t, resp.Users)
+}
+
+func TestStatsService_GetReviewerStats_OK(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	repo := mockStats.NewMockStatsRepository(ctrl)
+	service := usecase.NewStatsService(repo, usecase.Config{}, mockLogger.NewMockLogger())
+
+	from := time.Date(2025, 11, 1, 0, 0, 0, 0, time.UTC)
+	filter := entity.StatsFilter{TeamName: "backend", From: &from}
+
+	repo.EXPECT().TeamExists(ctx, "backend").Return(true, nil)
+	repo.EXPECT().GetTeamReviewStats(ctx, filter).Return([]entity.TeamReviewStats{
+		{TeamName: "backend", Members: 2, ReviewCounters: entity.ReviewCounters{Assignments: 7, OpenAssignments: 3, ReassignedAway: 1, MergedReviewed: 4}},
+	}, nil)
+	repo.EXPECT().GetReviewerStats(ctx, filter).Return([]entity.ReviewerStats{
+		{UserID: "uuid-1", Username: "alice", TeamName: "backend", ReviewCounters: entity.ReviewCounters{Assignments: 5, OpenAssignments: 2, MergedReviewed: 3}},
+		{UserID: "uuid-2", Username: "bob", TeamName: "backend", ReviewCounters: entity.ReviewCounters{Assignments: 2, OpenAssignments: 1, ReassignedAway: 1, MergedReviewed: 1}},
+	}, nil)
+
+	resp, err := service.GetReviewerStats(ctx, &dtoStats.StatsRequest{TeamName: "backend", From: "2025-11-01T03:00:00+03:00"})
+	require.NoError(t, err)
+	require.Equal(t, "2025-11-01T00:00:00Z", *resp.From)
+	require.Nil(t, resp.To)
+	require.Equal(t, []dtoStats.TeamReviewStatsDTO{
+		{TeamName: "backend", Members: 2, ReviewCountersDTO: dtoStats.ReviewCountersDTO{Assignments: 7, OpenAssignments: 3, ReassignedAway: 1, MergedReviewed: 4}},
+	}, resp.Teams)
+	require.Len(t, resp.Users, 2)
+	require.Equal(t, "alice", resp.Users[0].Username)
+	require.Equal(t, 1, resp.Users[1].ReassignedAway)
+}
@@ -2,9 +2,12 @@ package team_test
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"pr_reviewer_assignment_service/internal/dto"
+	dtoPR "pr_reviewer_assignment_service/internal/dto/pr"
 	teamDTO "pr_reviewer_assignment_service/internal/dto/team"
 	entity "pr_reviewer_assignment_service/internal/entity"
 	usecase "pr_reviewer_assignment_service/internal/usecase/team"
@@ -24,7 +27,7 @@ func TestTeamService_CreateTeam_AlreadyExists(t *testing.T) {
 	repo := mockTeam.NewMockTeamRepository(ctrl)
 	logger := mockLogger.NewMockLogger()
 
-	service := usecase.NewTeamService(repo, logger)
+	service := usecase.NewTeamService(repo, nil, logger)
 
 	req := &teamDTO.TeamRequest{
 		TeamName: "team-1",
@@ -50,7 +53,7 @@ func TestTeamService_CreateTeam_OK(t *testing.T) {
 	repo := mockTeam.NewMockTeamRepository(ctrl)
 	logger := mockLogger.NewMockLogger()
 
-	service := usecase.NewTeamService(repo, logger)
+	service := usecase.NewTeamService(repo, nil, logger)
 
 	req := &teamDTO.TeamRequest{
 		TeamName: "team-1",
@@ -72,6 +75,28 @@ func TestTeamService_CreateTeam_OK(t *testing.T) {
 	require.Len(t, resp.Members, 2)
 }
 
+func TestTeamService_CreateTeam_InvalidMaxReviewers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	repo := mockTeam.NewMockTeamRepository(ctrl)
+	logger := mockLogger.NewMockLogger()
+
+	service := usecase.NewTeamService(repo, nil, logger)
+
+	maxReviewers := -1
+	req := &teamDTO.TeamRequest{
+		TeamName:     "team-1",
+		MaxReviewers: &maxReviewers,
+	}
+
+	resp, err := service.CreateTeam(ctx, req)
+	require.Nil(t, resp)
+	require.ErrorIs(t, err, dto.ErrInvalidReviewersCount)
+}
+
 func TestTeamService_GetTeamByName_OK(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -81,7 +106,7 @@ func TestTeamService_GetTeamByName_OK(t *testing.T) {
 	repo := mockTeam.NewMockTeamRepository(ctrl)
 	logger := mockLogger.NewMockLogger()
 
-	service := usecase.NewTeamService(repo, logger)
+	service := usecase.NewTeamService(repo, nil, logger)
 
 	teamName := "team-1"
 
@@ -95,7 +120,7 @@ func TestTeamService_GetTeamByName_OK(t *testing.T) {
 
 	repo.EXPECT().GetTeamByName(ctx, teamName).Return(entityTeam, nil)
 
-	resp, err := service.GetTeamByName(ctx, teamName)
+	resp, err := service.GetTeamByName(ctx, teamName, false)
 	require.NoError(t, err)
 	require.NotNil(t, resp)
 	require.Equal(t, teamName, resp.TeamName)
@@ -111,13 +136,503 @@ func TestTeamService_GetTeamByName_NotFound(t *testing.T) {
 	repo := mockTeam.NewMockTeamRepository(ctrl)
 	logger := mockLogger.NewMockLogger()
 
-	service := usecase.NewTeamService(repo, logger)
+	service := usecase.NewTeamService(repo, nil, logger)
 
 	teamName := "team-1"
 
 	repo.EXPECT().GetTeamByName(ctx, teamName).Return(nil, dto.ErrNotFound)
 
-	resp, err := service.GetTeamByName(ctx, teamName)
+	resp, err := service.GetTeamByName(ctx, teamName, false)
+	require.Nil(t, resp)
+	require.ErrorIs(t, err, dto.ErrNotFound)
+}
+
+func TestTeamService_SetMergePolicy_Invalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	repo := mockTeam.NewMockTeamRepository(ctrl)
+	logger := mockLogger.NewMockLogger()
+
+	service := usecase.NewTeamService(repo, nil, logger)
+
+	resp, err := service.SetMergePolicy(ctx, &teamDTO.MergePolicyDTO{TeamName: "team-1", MinApprovals: -1})
+	require.Nil(t, resp)
+	require.ErrorIs(t, err, dto.ErrInvalidPolicy)
+}
+
+func TestTeamService_SetMergePolicy_RequiredTeamNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	repo := mockTeam.NewMockTeamRepository(ctrl)
+	logger := mockLogger.NewMockLogger()
+
+	service := usecase.NewTeamService(repo, nil, logger)
+
+	security := "security"
+	repo.EXPECT().GetTeamByName(ctx, "team-1").Return(&entity.Team{TeamName: "team-1"}, nil)
+	repo.EXPECT().GetTeamByName(ctx, security).Return(nil, dto.ErrNotFound)
+
+	resp, err := service.SetMergePolicy(ctx, &teamDTO.MergePolicyDTO{
+		TeamName:             "team-1",
+		MinApprovals:         1,
+		RequiredReviewerTeam: &security,
+	})
 	require.Nil(t, resp)
 	require.ErrorIs(t, err, dto.ErrNotFound)
 }
+
+func TestTeamService_SetMergePolicy_OK(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	repo := mockTeam.NewMockTeamRepository(ctrl)
+	logger := mockLogger.NewMockLogger()
+
+	service := usecase.NewTeamService(repo, nil, logger)
+
+	req := &teamDTO.MergePolicyDTO{
+		TeamName:                 "team-1",
+		MinApprovals:             2,
+		BlockOnChangesRequested:  true,
+		ForbidAuthorOnlyApproval: true,
+	}
+
+	repo.EXPECT().GetTeamByName(ctx, "team-1").Return(&entity.Team{TeamName: "team-1"}, nil)
+	repo.EXPECT().SetMergePolicy(ctx, &entity.MergePolicy{
+		TeamName:                 "team-1",
+		MinApprovals:             2,
+		BlockOnChangesRequested:  true,
+		ForbidAuthorOnlyApproval: true,
+	}).Return(nil)
+
+	resp, err := service.SetMergePolicy(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, 2, resp.MinApprovals)
+	require.True(t, resp.BlockOnChangesRequested)
+}
+
+func TestTeamService_UpdateTeam_Conflicting(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	repo := mockTeam.NewMockTeamRepository(ctrl)
+	logger := mockLogger.NewMockLogger()
+
+	service := usecase.NewTeamService(repo, nil, logger)
+
+	resp, err := service.UpdateTeam(ctx, &teamDTO.UpdateTeamRequest{
+		TeamName:      "team-1",
+		AddMembers:    []teamDTO.TeamMember{{UserID: "uuid-1", Username: "user1", IsActive: true}},
+		RemoveMembers: []string{"uuid-1"},
+	})
+	require.Nil(t, resp)
+	require.ErrorIs(t, err, dto.ErrInvalidTeamUpdate)
+}
+
+func TestTeamService_UpdateTeam_NotMember(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	repo := mockTeam.NewMockTeamRepository(ctrl)
+	reassigner := mockTeam.NewMockReviewReassigner(ctrl)
+	logger := mockLogger.NewMockLogger()
+
+	service := usecase.NewTeamService(repo, reassigner, logger)
+
+	reassigner.EXPECT().PlanTeamReassignments(ctx, []string{"uuid-9"}, "team-1").Return([]entity.Reassignment{}, nil)
+	repo.EXPECT().UpdateTeam(ctx, gomock.Any(), []entity.Reassignment{}).Return(nil, dto.ErrNotTeamMember)
+
+	resp, err := service.UpdateTeam(ctx, &teamDTO.UpdateTeamRequest{
+		TeamName:      "team-1",
+		RemoveMembers: []string{"uuid-9"},
+	})
+	require.Nil(t, resp)
+	require.ErrorIs(t, err, dto.ErrNotTeamMember)
+}
+
+func TestTeamService_UpdateTeam_OK(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	repo := mockTeam.NewMockTeamRepository(ctrl)
+	reassigner := mockTeam.NewMockReviewReassigner(ctrl)
+	logger := mockLogger.NewMockLogger()
+
+	service := usecase.NewTeamService(repo, reassigner, logger)
+
+	req := &teamDTO.UpdateTeamRequest{
+		TeamName:      "team-1",
+		AddMembers:    []teamDTO.TeamMember{{UserID: "uuid-3", Username: "user3", IsActive: true}},
+		RemoveMembers: []string{"uuid-2"},
+		RenameMembers: []teamDTO.RenameMember{{UserID: "uuid-1", Username: "renamed"}},
+	}
+
+	plan := []entity.Reassignment{
+		{PullRequestID: "pr-1", OldUserID: "uuid-2", NewUserID: "uuid-1"},
+		{PullRequestID: "pr-2", OldUserID: "uuid-2", NewUserID: "uuid-1"},
+	}
+
+	gomock.InOrder(
+		reassigner.EXPECT().PlanTeamReassignments(ctx, []string{"uuid-2"}, "team-1").Return(plan, nil),
+		repo.EXPECT().UpdateTeam(ctx, &entity.TeamUpdate{
+			TeamName: "team-1",
+			Add:      []entity.User{{UserID: "uuid-3", Username: "user3", TeamName: "team-1", IsActive: true}},
+			Remove:   []string{"uuid-2"},
+			Rename:   []entity.User{{UserID: "uuid-1", Username: "renamed", TeamName: "team-1"}},
+		}, plan).Return([]entity.Reassignment{
+			{PullRequestID: "pr-1", OldUserID: "uuid-2", NewUserID: "uuid-1"},
+			{PullRequestID: "pr-2", OldUserID: "uuid-2"},
+		}, nil),
+		repo.EXPECT().GetTeamByName(ctx, "team-1").Return(&entity.Team{
+			TeamName: "team-1",
+			Members: []entity.User{
+				{UserID: "uuid-1", Username: "renamed", TeamName: "team-1", IsActive: true},
+				{UserID: "uuid-3", Username: "user3", TeamName: "team-1", IsActive: true},
+			},
+		}, nil),
+	)
+
+	resp, err := service.UpdateTeam(ctx, req)
+	require.NoError(t, err)
+	require.Len(t, resp.Team.Members, 2)
+	require.Equal(t, "renamed", resp.Team.Members[0].Username)
+	require.Equal(t, []dtoPR.ReassignmentDTO{
+		{PullRequestID: "pr-1", OldUserID: "uuid-2", NewUserID: "uuid-1"},
+		{PullRequestID: "pr-2", OldUserID: "uuid-2"},
+	}, resp.Reassignments)
+}
+
+func TestTeamService_DeleteTeam_InvalidMode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	repo := mockTeam.NewMockTeamRepository(ctrl)
+	logger := mockLogger.NewMockLogger()
+
+	service := usecase.NewTeamService(repo, nil, logger)
+
+	resp, err := service.DeleteTeam(ctx, &teamDTO.DeleteTeamRequest{TeamName: "team-1", Mode: "soft"})
+	require.Nil(t, resp)
+	require.ErrorIs(t, err, dto.ErrInvalidDeleteMode)
+}
+
+func TestTeamService_DeleteTeam_HardWithHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	repo := mockTeam.NewMockTeamRepository(ctrl)
+	logger := mockLogger.NewMockLogger()
+
+	service := usecase.NewTeamService(repo, nil, logger)
+
+	repo.EXPECT().DeleteTeam(ctx, "team-1").Return(dto.ErrTeamHasHistory)
+
+	resp, err := service.DeleteTeam(ctx, &teamDTO.DeleteTeamRequest{TeamName: "team-1", Mode: usecase.TeamDeleteHard})
+	require.Nil(t, resp)
+	require.ErrorIs(t, err, dto.ErrTeamHasHistory)
+}
+
+func TestTeamService_DeleteTeam_Archive(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	repo := mockTeam.NewMockTeamRepository(ctrl)
+	reassigner := mockTeam.NewMockReviewReassigner(ctrl)
+	logger := mockLogger.NewMockLogger()
+
+	service := usecase.NewTeamService(repo, reassigner, logger)
+
+	plan := []entity.Reassignment{
+		{PullRequestID: "pr-1", OldUserID: "uuid-1", NewUserID: "uuid-9"},
+		{PullRequestID: "pr-2", OldUserID: "uuid-2"},
+	}
+
+	gomock.InOrder(
+		repo.EXPECT().GetTeamWithArchived(ctx, "team-1").Return(&entity.Team{
+			TeamName: "team-1",
+			Members: []entity.User{
+				{UserID: "uuid-1", TeamName: "team-1", IsActive: true},
+				{UserID: "uuid-3", TeamName: "team-2", IsActive: true},
+				{UserID: "uuid-2", TeamName: "team-1", IsActive: true},
+			},
+		}, nil),
+		repo.EXPECT().ActiveInOtherTeams(ctx, "team-1", []string{"uuid-1", "uuid-3", "uuid-2"}).Return([]string{"uuid-3"}, nil),
+		reassigner.EXPECT().PlanArchiveReassignments(ctx, []string{"uuid-1", "uuid-3", "uuid-2"}, "team-1", []string{"uuid-3"}).Return(plan, nil),
+		repo.EXPECT().ArchiveTeam(ctx, "team-1", plan).Return([]entity.Reassignment{
+			{PullRequestID: "pr-1", OldUserID: "uuid-1", NewUserID: "uuid-9"},
+			{PullRequestID: "pr-2", OldUserID: "uuid-2", Released: true},
+		}, nil),
+	)
+
+	resp, err := service.DeleteTeam(ctx, &teamDTO.DeleteTeamRequest{TeamName: "team-1", Mode: usecase.TeamDeleteArchive})
+	require.NoError(t, err)
+	require.Equal(t, []dtoPR.ReassignmentDTO{
+		{PullRequestID: "pr-1", OldUserID: "uuid-1", NewUserID: "uuid-9"},
+		{PullRequestID: "pr-2", OldUserID: "uuid-2", Released: true},
+	}, resp.Reassignments)
+}
+
+func TestTeamService_GetTeamByName_IncludeArchived(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	repo := mockTeam.NewMockTeamRepository(ctrl)
+	logger := mockLogger.NewMockLogger()
+
+	service := usecase.NewTeamService(repo, nil, logger)
+
+	archivedAt := time.Date(2025, 11, 20, 9, 0, 0, 0, time.UTC)
+	repo.EXPECT().GetTeamWithArchived(ctx, "team-1").Return(&entity.Team{TeamName: "team-1", ArchivedAt: &archivedAt}, nil)
+
+	resp, err := service.GetTeamByName(ctx, "team-1", true)
+	require.NoError(t, err)
+	require.Equal(t, "2025-11-20T09:00:00Z", *resp.ArchivedAt)
+}
+
+func TestTeamService_CreateTeam_InvalidRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	repo := mockTeam.NewMockTeamRepository(ctrl)
+	logger := mockLogger.NewMockLogger()
+
+	service := usecase.NewTeamService(repo, nil, logger)
+
+	repo.EXPECT().GetTeamByName(ctx, "team-1").Return(nil, dto.ErrNotFound)
+
+	resp, err := service.CreateTeam(ctx, &teamDTO.TeamRequest{
+		TeamName: "team-1",
+		Members:  []teamDTO.TeamMember{{UserID: "uuid-1", Username: "user1", IsActive: true, Role: "owner"}},
+	})
+	require.Nil(t, resp)
+	require.ErrorIs(t, err, dto.ErrInvalidMembership)
+}
+
+func TestTeamService_SetMembership(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	repo := mockTeam.NewMockTeamRepository(ctrl)
+	logger := mockLogger.NewMockLogger()
+
+	service := usecase.NewTeamService(repo, nil, logger)
+
+	t.Run("nothing to change", func(t *testing.T) {
+		resp, err := service.SetMembership(ctx, &teamDTO.SetMembershipRequest{TeamName: "team-1", UserID: "uuid-1"})
+		require.Nil(t, resp)
+		require.ErrorIs(t, err, dto.ErrInvalidMembership)
+	})
+
+	t.Run("ok", func(t *testing.T) {
+		role := entity.RoleLead
+		inactive := false
+		joinedAt := time.Date(2025, 11, 20, 9, 0, 0, 0, time.UTC)
+
+		repo.EXPECT().UpdateMembership(ctx, "team-1", "uuid-1", &role, &inactive).Return(&entity.Membership{
+			TeamName: "team-1",
+			UserID:   "uuid-1",
+			Role:     entity.RoleLead,
+			IsActive: false,
+			JoinedAt: joinedAt,
+		}, nil)
+
+		resp, err := service.SetMembership(ctx, &teamDTO.SetMembershipRequest{
+			TeamName: "team-1",
+			UserID:   "uuid-1",
+			Role:     &role,
+			IsActive: &inactive,
+		})
+		require.NoError(t, err)
+		require.Equal(t, entity.RoleLead, resp.Role)
+		require.False(t, resp.IsActive)
+		require.Equal(t, "2025-11-20T09:00:00Z", resp.JoinedAt)
+	})
+}
+
+func TestTeamService_SetFallbackTeams(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	repo := mockTeam.NewMockTeamRepository(ctrl)
+	logger := mockLogger.NewMockLogger()
+
+	service := usecase.NewTeamService(repo, nil, logger)
+
+	for name, fallbacks := range map[string][]string{
+		"self":      {"team-1"},
+		"duplicate": {"team-2", "team-2"},
+		"empty":     {""},
+	} {
+		t.Run(name, func(t *testing.T) {
+			repo.EXPECT().GetTeamByName(ctx, gomock.Any()).Return(&entity.Team{}, nil).AnyTimes()
+
+			resp, err := service.SetFallbackTeams(ctx, &teamDTO.FallbackTeamsDTO{TeamName: "team-1", FallbackTeams: fallbacks})
+			require.Nil(t, resp)
+			require.ErrorIs(t, err, dto.ErrInvalidFallback)
+		})
+	}
+
+	t.Run("fallback team not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		repo := mockTeam.NewMockTeamRepository(ctrl)
+		service := usecase.NewTeamService(repo, nil, logger)
+
+		repo.EXPECT().GetTeamByName(ctx, "team-2").Return(nil, dto.ErrNotFound)
+
+		resp, err := service.SetFallbackTeams(ctx, &teamDTO.FallbackTeamsDTO{TeamName: "team-1", FallbackTeams: []string{"team-2"}})
+		require.Nil(t, resp)
+		require.ErrorIs(t, err, dto.ErrNotFound)
+	})
+
+	t.Run("ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		repo := mockTeam.NewMockTeamRepository(ctrl)
+		service := usecase.NewTeamService(repo, nil, logger)
+
+		repo.EXPECT().GetTeamByName(ctx, "team-2").Return(&entity.Team{TeamName: "team-2"}, nil)
+		repo.EXPECT().GetTeamByName(ctx, "team-3").Return(&entity.Team{TeamName: "team-3"}, nil)
+		repo.EXPECT().SetFallbackTeams(ctx, "team-1", []string{"team-2", "team-3"}).Return(nil)
+
+		resp, err := service.SetFallbackTeams(ctx, &teamDTO.FallbackTeamsDTO{TeamName: "team-1", FallbackTeams: []string{"team-2", "team-3"}})
+		require.NoError(t, err)
+		require.Equal(t, []string{"team-2", "team-3"}, resp.FallbackTeams)
+	})
+}
+
+func TestTeamService_DeactivateMembers(t *testing.T) {
+	ctx := context.Background()
+	logger := mockLogger.NewMockLogger()
+
+	team := &entity.Team{
+		TeamName: "team-1",
+		Members:  []entity.User{{UserID: "uuid-1"}, {UserID: "uuid-2"}, {UserID: "uuid-3"}},
+	}
+
+	for name, req := range map[string]*teamDTO.DeactivateUsersRequest{
+		"neither":   {TeamName: "team-1"},
+		"both":      {TeamName: "team-1", UserIDs: []string{"uuid-1"}, All: true},
+		"duplicate": {TeamName: "team-1", UserIDs: []string{"uuid-1", "uuid-1"}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			repo := mockTeam.NewMockTeamRepository(ctrl)
+			service := usecase.NewTeamService(repo, nil, logger)
+
+			repo.EXPECT().GetTeamByName(ctx, "team-1").Return(team, nil).AnyTimes()
+
+			resp, err := service.DeactivateMembers(ctx, req)
+			require.Nil(t, resp)
+			require.ErrorIs(t, err, dto.ErrInvalidDeactivate)
+		})
+	}
+
+	t.Run("not a member", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		repo := mockTeam.NewMockTeamRepository(ctrl)
+		service := usecase.NewTeamService(repo, nil, logger)
+
+		repo.EXPECT().GetTeamByName(ctx, "team-1").Return(team, nil)
+
+		resp, err := service.DeactivateMembers(ctx, &teamDTO.DeactivateUsersRequest{TeamName: "team-1", UserIDs: []string{"uuid-9"}})
+		require.Nil(t, resp)
+		require.ErrorIs(t, err, dto.ErrNotTeamMember)
+	})
+
+	t.Run("all members", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		repo := mockTeam.NewMockTeamRepository(ctrl)
+		reassigner := mockTeam.NewMockReviewReassigner(ctrl)
+		service := usecase.NewTeamService(repo, reassigner, logger)
+
+		userIDs := []string{"uuid-1", "uuid-2", "uuid-3"}
+		plan := []entity.Reassignment{
+			{PullRequestID: "pr-1", OldUserID: "uuid-1", NewUserID: "uuid-7"},
+			{PullRequestID: "pr-2", OldUserID: "uuid-2"},
+		}
+
+		gomock.InOrder(
+			repo.EXPECT().GetTeamByName(ctx, "team-1").Return(team, nil),
+			reassigner.EXPECT().PlanTeamReassignments(ctx, userIDs, "team-1").Return(plan, nil),
+			repo.EXPECT().DeactivateMembers(ctx, "team-1", userIDs, plan).Return(plan, nil),
+		)
+
+		resp, err := service.DeactivateMembers(ctx, &teamDTO.DeactivateUsersRequest{TeamName: "team-1", All: true})
+		require.NoError(t, err)
+		require.Equal(t, userIDs, resp.Deactivated)
+		require.Equal(t, []dtoPR.ReassignmentDTO{
+			{PullRequestID: "pr-1", OldUserID: "uuid-1", NewUserID: "uuid-7"},
+			{PullRequestID: "pr-2", OldUserID: "uuid-2"},
+		}, resp.Reassignments)
+	})
+}
+
+func TestTeamService_UpdateTeam_PlanFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	repo := mockTeam.NewMockTeamRepository(ctrl)
+	reassigner := mockTeam.NewMockReviewReassigner(ctrl)
+	logger := mockLogger.NewMockLogger()
+
+	service := usecase.NewTeamService(repo, reassigner, logger)
+
+	planErr := errors.New("db is down")
+	reassigner.EXPECT().PlanTeamReassignments(ctx, []string{"uuid-2"}, "team-1").Return(nil, planErr)
+
+	resp, err := service.UpdateTeam(ctx, &teamDTO.UpdateTeamRequest{
+		TeamName:      "team-1",
+		RemoveMembers: []string{"uuid-2"},
+	})
+	require.Nil(t, resp)
+	require.ErrorIs(t, err, planErr)
+}
+
+func TestTeamService_DeleteTeam_ArchiveAlreadyArchived(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	repo := mockTeam.NewMockTeamRepository(ctrl)
+	reassigner := mockTeam.NewMockReviewReassigner(ctrl)
+	logger := mockLogger.NewMockLogger()
+
+	service := usecase.NewTeamService(repo, reassigner, logger)
+
+	archivedAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	repo.EXPECT().GetTeamWithArchived(ctx, "team-1").Return(&entity.Team{TeamName: "team-1", ArchivedAt: &archivedAt}, nil)
+
+	resp, err := service.DeleteTeam(ctx, &teamDTO.DeleteTeamRequest{TeamName: "team-1", Mode: usecase.TeamDeleteArchive})
+	require.Nil(t, resp)
+	require.ErrorIs(t, err, dto.ErrTeamArchived)
+}
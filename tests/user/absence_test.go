@@ -0,0 +1,142 @@
+package user_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"pr_reviewer_assignment_service/internal/dto"
+	"pr_reviewer_assignment_service/internal/dto/user"
+	"pr_reviewer_assignment_service/internal/entity"
+	usecase "pr_reviewer_assignment_service/internal/usecase/user"
+	mockLogger "pr_reviewer_assignment_service/mocks/logger"
+	mockUser "pr_reviewer_assignment_service/mocks/user"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserService_AddAbsence(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mockUser.NewMockUserRepository(ctrl)
+	mockLogger := mockLogger.NewMockLogger()
+
+	svc := usecase.NewUserService(mockRepo, nil, nil, mockLogger)
+
+	startsAt := time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)
+	endsAt := time.Date(2025, 12, 15, 0, 0, 0, 0, time.UTC)
+
+	t.Run("ends before start", func(t *testing.T) {
+		resp, err := svc.AddAbsence(ctx, &user.AddAbsenceRequest{UserID: "uuid-1", StartsAt: endsAt, EndsAt: startsAt})
+		require.Nil(t, resp)
+		require.ErrorIs(t, err, dto.ErrInvalidAbsence)
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		mockRepo.EXPECT().AddAbsence(ctx, gomock.Any()).Return(nil, dto.ErrNotFound)
+
+		resp, err := svc.AddAbsence(ctx, &user.AddAbsenceRequest{UserID: "ghost", StartsAt: startsAt, EndsAt: endsAt})
+		require.Nil(t, resp)
+		require.ErrorIs(t, err, dto.ErrNotFound)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo.EXPECT().AddAbsence(ctx, &entity.Absence{
+			UserID:              "uuid-1",
+			StartsAt:            startsAt,
+			EndsAt:              endsAt,
+			Reason:              "vacation",
+			ReassignOpenReviews: true,
+		}).Return(&entity.Absence{
+			AbsenceID:           7,
+			UserID:              "uuid-1",
+			StartsAt:            startsAt,
+			EndsAt:              endsAt,
+			Reason:              "vacation",
+			ReassignOpenReviews: true,
+		}, nil)
+
+		resp, err := svc.AddAbsence(ctx, &user.AddAbsenceRequest{
+			UserID:              "uuid-1",
+			StartsAt:            startsAt,
+			EndsAt:              endsAt,
+			Reason:              "vacation",
+			ReassignOpenReviews: true,
+		})
+		require.NoError(t, err)
+		require.Equal(t, int64(7), resp.AbsenceID)
+		require.Equal(t, "2025-12-01T00:00:00Z", resp.StartsAt)
+		require.Nil(t, resp.ReassignedAt)
+	})
+}
+
+func TestUserService_ReassignStartedAbsences(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 12, 1, 9, 0, 0, 0, time.UTC)
+
+	t.Run("reassigns and marks absences", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockRepo := mockUser.NewMockUserRepository(ctrl)
+		reassigner := mockUser.NewMockReviewReassigner(ctrl)
+
+		svc := usecase.NewUserService(mockRepo, nil, reassigner, mockLogger.NewMockLogger())
+
+		plan := []entity.Reassignment{{PullRequestID: "pr-1", OldUserID: "uuid-1", NewUserID: "uuid-3"}}
+		mockRepo.EXPECT().GetStartedAbsences(ctx, now).Return([]entity.Absence{
+			{AbsenceID: 1, UserID: "uuid-1"},
+			{AbsenceID: 2, UserID: "uuid-2"},
+		}, nil)
+		gomock.InOrder(
+			reassigner.EXPECT().PlanReassignments(ctx, []string{"uuid-1"}).Return(plan, nil),
+			mockRepo.EXPECT().MarkAbsenceReassigned(ctx, int64(1), now, plan).Return(plan, nil),
+			reassigner.EXPECT().PlanReassignments(ctx, []string{"uuid-2"}).Return([]entity.Reassignment{}, nil),
+			mockRepo.EXPECT().MarkAbsenceReassigned(ctx, int64(2), now, []entity.Reassignment{}).Return([]entity.Reassignment{}, nil),
+		)
+
+		n, err := svc.ReassignStartedAbsences(ctx, now)
+		require.NoError(t, err)
+		require.Equal(t, 2, n)
+	})
+
+	t.Run("failed absence does not block the rest", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockRepo := mockUser.NewMockUserRepository(ctrl)
+		reassigner := mockUser.NewMockReviewReassigner(ctrl)
+
+		svc := usecase.NewUserService(mockRepo, nil, reassigner, mockLogger.NewMockLogger())
+
+		mockRepo.EXPECT().GetStartedAbsences(ctx, now).Return([]entity.Absence{
+			{AbsenceID: 1, UserID: "uuid-1"},
+			{AbsenceID: 2, UserID: "uuid-2"},
+			{AbsenceID: 3, UserID: "uuid-3"},
+		}, nil)
+		gomock.InOrder(
+			reassigner.EXPECT().PlanReassignments(ctx, []string{"uuid-1"}).Return(nil, errors.New("db error")),
+			reassigner.EXPECT().PlanReassignments(ctx, []string{"uuid-2"}).Return(nil, nil),
+			mockRepo.EXPECT().MarkAbsenceReassigned(ctx, int64(2), now, nil).Return(nil, errors.New("db error")),
+			reassigner.EXPECT().PlanReassignments(ctx, []string{"uuid-3"}).Return(nil, nil),
+			mockRepo.EXPECT().MarkAbsenceReassigned(ctx, int64(3), now, nil).Return(nil, nil),
+		)
+
+		n, err := svc.ReassignStartedAbsences(ctx, now)
+		require.NoError(t, err)
+		require.Equal(t, 1, n)
+	})
+
+	t.Run("listing error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockRepo := mockUser.NewMockUserRepository(ctrl)
+
+		svc := usecase.NewUserService(mockRepo, nil, nil, mockLogger.NewMockLogger())
+
+		mockRepo.EXPECT().GetStartedAbsences(ctx, now).Return(nil, errors.New("db error"))
+
+		n, err := svc.ReassignStartedAbsences(ctx, now)
+		require.Error(t, err)
+		require.Zero(t, n)
+	})
+}
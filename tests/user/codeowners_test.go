@@ -0,0 +1,69 @@
+package user_test
+
+import (
+	"context"
+	"testing"
+
+	"pr_reviewer_assignment_service/internal/dto/user"
+	"pr_reviewer_assignment_service/internal/entity"
+	usecase "pr_reviewer_assignment_service/internal/usecase/user"
+	mockLogger "pr_reviewer_assignment_service/mocks/logger"
+	mockUser "pr_reviewer_assignment_service/mocks/user"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const codeownersFile = `*                   @acme/platform @ghost
+/internal/billing/  @alice @acme/payments @alice
+docs/[a-z]*.md      @bob
+*.sql               @sam dba@example.com
+`
+
+func TestUserService_ImportCodeowners(t *testing.T) {
+	ctx := context.Background()
+
+	expectResolve := func(repo *mockUser.MockUserRepository) {
+		repo.EXPECT().GetIDsByUsernames(ctx, []string{"alice", "ghost", "sam"}).Return(map[string][]string{
+			"alice": {"uuid-alice"},
+			"sam":   {"uuid-sam-1", "uuid-sam-2"},
+		}, nil)
+		repo.EXPECT().ExistingTeams(ctx, []string{"payments", "platform"}).Return([]string{"platform"}, nil)
+	}
+
+	t.Run("import", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		repo := mockUser.NewMockUserRepository(ctrl)
+		svc := usecase.NewUserService(repo, nil, nil, mockLogger.NewMockLogger())
+
+		expectResolve(repo)
+		repo.EXPECT().ReplaceCodeownersRules(ctx, "acme/billing", []entity.CodeownersRule{
+			{Position: 1, Line: 1, Pattern: "*", Teams: []string{"platform"}},
+			{Position: 2, Line: 2, Pattern: "/internal/billing/", UserIDs: []string{"uuid-alice"}},
+			{Position: 3, Line: 4, Pattern: "*.sql"},
+		}).Return(nil)
+
+		resp, err := svc.ImportCodeowners(ctx, &user.ImportCodeownersRequest{Repository: "acme/billing", Content: codeownersFile})
+		require.NoError(t, err)
+		require.Equal(t, &user.ImportCodeownersResponse{
+			Repository:       "acme/billing",
+			RulesImported:    3,
+			InvalidLines:     []int{3},
+			UnknownHandles:   []string{"@acme/payments", "@ghost", "dba@example.com"},
+			AmbiguousHandles: []string{"@sam"},
+		}, resp)
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		repo := mockUser.NewMockUserRepository(ctrl)
+		svc := usecase.NewUserService(repo, nil, nil, mockLogger.NewMockLogger())
+
+		expectResolve(repo)
+
+		resp, err := svc.ImportCodeowners(ctx, &user.ImportCodeownersRequest{Content: codeownersFile, DryRun: true})
+		require.NoError(t, err)
+		require.True(t, resp.DryRun)
+		require.Equal(t, 3, resp.RulesImported)
+	})
+}
@@ -4,7 +4,10 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
+	"pr_reviewer_assignment_service/internal/dto"
+	dtoPR "pr_reviewer_assignment_service/internal/dto/pr"
 	"pr_reviewer_assignment_service/internal/dto/user"
 	"pr_reviewer_assignment_service/internal/entity"
 	usecase "pr_reviewer_assignment_service/internal/usecase/user"
@@ -24,7 +27,7 @@ func TestUserService_SetActive(t *testing.T) {
 	mockPRRepo := mockUser.NewMockPRGetter(ctrl)
 	mockLogger := mockLogger.NewMockLogger()
 
-	svc := usecase.NewUserService(mockRepo, mockPRRepo, mockLogger)
+	svc := usecase.NewUserService(mockRepo, mockPRRepo, nil, mockLogger)
 
 	t.Run("success", func(t *testing.T) {
 		req := &user.SetIsActiveRequest{
@@ -72,7 +75,7 @@ func TestUserService_GetReview(t *testing.T) {
 	mockPRRepo := mockUser.NewMockPRGetter(ctrl)
 	mockLogger := mockLogger.NewMockLogger()
 
-	svc := usecase.NewUserService(mockRepo, mockPRRepo, mockLogger)
+	svc := usecase.NewUserService(mockRepo, mockPRRepo, nil, mockLogger)
 
 	t.Run("success", func(t *testing.T) {
 		userID := "uuid-123"
@@ -112,3 +115,199 @@ func TestUserService_GetReview(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func TestUserService_MoveUser(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mockUser.NewMockUserRepository(ctrl)
+	mockPRRepo := mockUser.NewMockPRGetter(ctrl)
+	mockReassigner := mockUser.NewMockReviewReassigner(ctrl)
+	mockLogger := mockLogger.NewMockLogger()
+
+	svc := usecase.NewUserService(mockRepo, mockPRRepo, mockReassigner, mockLogger)
+
+	movedAt := time.Date(2025, 11, 20, 9, 0, 0, 0, time.UTC)
+	moved := &entity.User{UserID: "uuid-1", Username: "user1", TeamName: "payments", IsActive: true}
+
+	t.Run("invalid mode", func(t *testing.T) {
+		resp, err := svc.MoveUser(ctx, &user.MoveUserRequest{UserID: "uuid-1", TeamName: "payments", OpenReviews: "drop"})
+		require.Nil(t, resp)
+		require.ErrorIs(t, err, dto.ErrInvalidMoveMode)
+	})
+
+	t.Run("same team", func(t *testing.T) {
+		mockRepo.EXPECT().MoveUser(ctx, "uuid-1", "backend", nil).Return(nil, nil, dto.ErrSameTeam)
+
+		resp, err := svc.MoveUser(ctx, &user.MoveUserRequest{UserID: "uuid-1", TeamName: "backend"})
+		require.Nil(t, resp)
+		require.ErrorIs(t, err, dto.ErrSameTeam)
+	})
+
+	t.Run("keep reviews", func(t *testing.T) {
+		mockRepo.EXPECT().MoveUser(ctx, "uuid-1", "payments", nil).
+			Return(&entity.UserMove{UserID: "uuid-1", FromTeam: "backend", ToTeam: "payments", MovedAt: movedAt}, nil, nil)
+		mockRepo.EXPECT().GetByID(ctx, "uuid-1").Return(moved, nil)
+
+		resp, err := svc.MoveUser(ctx, &user.MoveUserRequest{UserID: "uuid-1", TeamName: "payments"})
+		require.NoError(t, err)
+		require.Equal(t, "payments", resp.User.TeamName)
+		require.Equal(t, "backend", resp.FromTeam)
+		require.Equal(t, "2025-11-20T09:00:00Z", resp.MovedAt)
+		require.Empty(t, resp.Reassignments)
+	})
+
+	current := &entity.User{UserID: "uuid-1", Username: "user1", TeamName: "backend", IsActive: true}
+
+	t.Run("rebalance reviews", func(t *testing.T) {
+		plan := []entity.Reassignment{{PullRequestID: "pr-1", OldUserID: "uuid-1", NewUserID: "uuid-2"}}
+		gomock.InOrder(
+			mockRepo.EXPECT().GetByID(ctx, "uuid-1").Return(current, nil),
+			mockReassigner.EXPECT().PlanTeamReassignments(ctx, []string{"uuid-1"}, "backend").Return(plan, nil),
+			mockRepo.EXPECT().MoveUser(ctx, "uuid-1", "payments", plan).
+				Return(&entity.UserMove{UserID: "uuid-1", FromTeam: "backend", ToTeam: "payments", MovedAt: movedAt}, plan, nil),
+			mockRepo.EXPECT().GetByID(ctx, "uuid-1").Return(moved, nil),
+		)
+
+		resp, err := svc.MoveUser(ctx, &user.MoveUserRequest{UserID: "uuid-1", TeamName: "payments", OpenReviews: usecase.MoveRebalanceReviews})
+		require.NoError(t, err)
+		require.Len(t, resp.Reassignments, 1)
+		require.Equal(t, "uuid-2", resp.Reassignments[0].NewUserID)
+	})
+
+	t.Run("rebalance plan fails", func(t *testing.T) {
+		planErr := errors.New("db down")
+		gomock.InOrder(
+			mockRepo.EXPECT().GetByID(ctx, "uuid-1").Return(current, nil),
+			mockReassigner.EXPECT().PlanTeamReassignments(ctx, []string{"uuid-1"}, "backend").Return(nil, planErr),
+		)
+
+		resp, err := svc.MoveUser(ctx, &user.MoveUserRequest{UserID: "uuid-1", TeamName: "payments", OpenReviews: usecase.MoveRebalanceReviews})
+		require.Nil(t, resp)
+		require.ErrorIs(t, err, planErr)
+	})
+}
+
+func TestUserService_SetMaxOpenReviews(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mockUser.NewMockUserRepository(ctrl)
+	mockLogger := mockLogger.NewMockLogger()
+
+	svc := usecase.NewUserService(mockRepo, nil, nil, mockLogger)
+
+	t.Run("negative limit", func(t *testing.T) {
+		limit := -1
+		resp, err := svc.SetMaxOpenReviews(ctx, &user.SetMaxOpenReviewsRequest{UserID: "uuid-123", MaxOpenReviews: &limit})
+		require.Nil(t, resp)
+		require.ErrorIs(t, err, dto.ErrInvalidCapacity)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		limit := 3
+		mockRepo.EXPECT().
+			SetMaxOpenReviews(ctx, "uuid-123", &limit).
+			Return(&entity.User{UserID: "uuid-123", TeamName: "team1", IsActive: true, MaxOpenReviews: &limit}, nil)
+
+		resp, err := svc.SetMaxOpenReviews(ctx, &user.SetMaxOpenReviewsRequest{UserID: "uuid-123", MaxOpenReviews: &limit})
+		require.NoError(t, err)
+		require.Equal(t, 3, *resp.MaxOpenReviews)
+	})
+
+	t.Run("clear limit", func(t *testing.T) {
+		mockRepo.EXPECT().
+			SetMaxOpenReviews(ctx, "uuid-123", nil).
+			Return(&entity.User{UserID: "uuid-123", TeamName: "team1", IsActive: true}, nil)
+
+		resp, err := svc.SetMaxOpenReviews(ctx, &user.SetMaxOpenReviewsRequest{UserID: "uuid-123"})
+		require.NoError(t, err)
+		require.Nil(t, resp.MaxOpenReviews)
+	})
+}
+
+func TestUserService_Deactivate(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mockUser.NewMockUserRepository(ctrl)
+	reassigner := mockUser.NewMockReviewReassigner(ctrl)
+	mockLogger := mockLogger.NewMockLogger()
+
+	svc := usecase.NewUserService(mockRepo, nil, reassigner, mockLogger)
+
+	t.Run("success", func(t *testing.T) {
+		plan := []entity.Reassignment{
+			{PullRequestID: "pr-1", OldUserID: "uuid-1", NewUserID: "uuid-2"},
+			{PullRequestID: "pr-2", OldUserID: "uuid-1"},
+			{PullRequestID: "pr-3", OldUserID: "uuid-1", NewUserID: "uuid-3"},
+		}
+		reassigner.EXPECT().PlanReassignments(ctx, []string{"uuid-1"}).Return(plan, nil)
+		mockRepo.EXPECT().Deactivate(ctx, "uuid-1", plan).Return(
+			&entity.User{UserID: "uuid-1", TeamName: "team1", IsActive: false},
+			plan[:2],
+			nil,
+		)
+
+		resp, err := svc.Deactivate(ctx, &user.SetIsActiveRequest{UserID: "uuid-1", ReassignOpenReviews: true})
+		require.NoError(t, err)
+		require.False(t, resp.User.IsActive)
+		require.Equal(t, []dtoPR.ReassignmentDTO{
+			{PullRequestID: "pr-1", OldUserID: "uuid-1", NewUserID: "uuid-2"},
+			{PullRequestID: "pr-2", OldUserID: "uuid-1"},
+		}, resp.Reassignments)
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		reassigner.EXPECT().PlanReassignments(ctx, []string{"ghost"}).Return([]entity.Reassignment{}, nil)
+		mockRepo.EXPECT().Deactivate(ctx, "ghost", []entity.Reassignment{}).Return(nil, nil, dto.ErrNotFound)
+
+		resp, err := svc.Deactivate(ctx, &user.SetIsActiveRequest{UserID: "ghost", ReassignOpenReviews: true})
+		require.Nil(t, resp)
+		require.ErrorIs(t, err, dto.ErrNotFound)
+	})
+
+	t.Run("plan out of date", func(t *testing.T) {
+		plan := []entity.Reassignment{{PullRequestID: "pr-1", OldUserID: "uuid-1", NewUserID: "uuid-2"}}
+		reassigner.EXPECT().PlanReassignments(ctx, []string{"uuid-1"}).Return(plan, nil)
+		mockRepo.EXPECT().Deactivate(ctx, "uuid-1", plan).Return(nil, nil, dto.ErrReviewersChanged)
+
+		resp, err := svc.Deactivate(ctx, &user.SetIsActiveRequest{UserID: "uuid-1", ReassignOpenReviews: true})
+		require.Nil(t, resp)
+		require.ErrorIs(t, err, dto.ErrReviewersChanged)
+	})
+}
+
+func TestUserService_SetOwnershipPatterns(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mockUser.NewMockUserRepository(ctrl)
+	mockLogger := mockLogger.NewMockLogger()
+
+	svc := usecase.NewUserService(mockRepo, nil, nil, mockLogger)
+
+	for name, patterns := range map[string][]string{
+		"empty pattern": {"*.go", ""},
+		"duplicate":     {"docs/", " docs/"},
+		"bracket":       {"src/[ab].go"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			resp, err := svc.SetOwnershipPatterns(ctx, &user.OwnershipPatternsDTO{UserID: "uuid-123", Patterns: patterns})
+			require.Nil(t, resp)
+			require.ErrorIs(t, err, dto.ErrInvalidOwnership)
+		})
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo.EXPECT().SetOwnershipPatterns(ctx, "uuid-123", []string{"/internal/billing/", "*.sql"}).Return(nil)
+
+		resp, err := svc.SetOwnershipPatterns(ctx, &user.OwnershipPatternsDTO{UserID: "uuid-123", Patterns: []string{" /internal/billing/", "*.sql"}})
+		require.NoError(t, err)
+		require.Equal(t, []string{"/internal/billing/", "*.sql"}, resp.Patterns)
+	})
+}